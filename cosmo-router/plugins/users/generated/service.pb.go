@@ -21,6 +21,224 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Nullable user fields that an update can set to null
+type NullableUserField int32
+
+const (
+	NullableUserField_NULLABLE_USER_FIELD_UNSPECIFIED NullableUserField = 0
+	NullableUserField_NULLABLE_USER_FIELD_TAGS        NullableUserField = 1
+	NullableUserField_NULLABLE_USER_FIELD_BIO         NullableUserField = 2
+	NullableUserField_NULLABLE_USER_FIELD_AGE         NullableUserField = 3
+	NullableUserField_NULLABLE_USER_FIELD_PROFILE     NullableUserField = 4
+)
+
+// Enum value maps for NullableUserField.
+var (
+	NullableUserField_name = map[int32]string{
+		0: "NULLABLE_USER_FIELD_UNSPECIFIED",
+		1: "NULLABLE_USER_FIELD_TAGS",
+		2: "NULLABLE_USER_FIELD_BIO",
+		3: "NULLABLE_USER_FIELD_AGE",
+		4: "NULLABLE_USER_FIELD_PROFILE",
+	}
+	NullableUserField_value = map[string]int32{
+		"NULLABLE_USER_FIELD_UNSPECIFIED": 0,
+		"NULLABLE_USER_FIELD_TAGS":        1,
+		"NULLABLE_USER_FIELD_BIO":         2,
+		"NULLABLE_USER_FIELD_AGE":         3,
+		"NULLABLE_USER_FIELD_PROFILE":     4,
+	}
+)
+
+func (x NullableUserField) Enum() *NullableUserField {
+	p := new(NullableUserField)
+	*p = x
+	return p
+}
+
+func (x NullableUserField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NullableUserField) Descriptor() protoreflect.EnumDescriptor {
+	return file_generated_service_proto_enumTypes[0].Descriptor()
+}
+
+func (NullableUserField) Type() protoreflect.EnumType {
+	return &file_generated_service_proto_enumTypes[0]
+}
+
+func (x NullableUserField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NullableUserField.Descriptor instead.
+func (NullableUserField) EnumDescriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Fields users can be sorted by
+type UserOrderField int32
+
+const (
+	UserOrderField_USER_ORDER_FIELD_UNSPECIFIED UserOrderField = 0
+	UserOrderField_USER_ORDER_FIELD_ID          UserOrderField = 1
+	UserOrderField_USER_ORDER_FIELD_NAME        UserOrderField = 2
+	UserOrderField_USER_ORDER_FIELD_EMAIL       UserOrderField = 3
+	UserOrderField_USER_ORDER_FIELD_AGE         UserOrderField = 4
+	UserOrderField_USER_ORDER_FIELD_ROLE        UserOrderField = 5
+)
+
+// Enum value maps for UserOrderField.
+var (
+	UserOrderField_name = map[int32]string{
+		0: "USER_ORDER_FIELD_UNSPECIFIED",
+		1: "USER_ORDER_FIELD_ID",
+		2: "USER_ORDER_FIELD_NAME",
+		3: "USER_ORDER_FIELD_EMAIL",
+		4: "USER_ORDER_FIELD_AGE",
+		5: "USER_ORDER_FIELD_ROLE",
+	}
+	UserOrderField_value = map[string]int32{
+		"USER_ORDER_FIELD_UNSPECIFIED": 0,
+		"USER_ORDER_FIELD_ID":          1,
+		"USER_ORDER_FIELD_NAME":        2,
+		"USER_ORDER_FIELD_EMAIL":       3,
+		"USER_ORDER_FIELD_AGE":         4,
+		"USER_ORDER_FIELD_ROLE":        5,
+	}
+)
+
+func (x UserOrderField) Enum() *UserOrderField {
+	p := new(UserOrderField)
+	*p = x
+	return p
+}
+
+func (x UserOrderField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserOrderField) Descriptor() protoreflect.EnumDescriptor {
+	return file_generated_service_proto_enumTypes[1].Descriptor()
+}
+
+func (UserOrderField) Type() protoreflect.EnumType {
+	return &file_generated_service_proto_enumTypes[1]
+}
+
+func (x UserOrderField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserOrderField.Descriptor instead.
+func (UserOrderField) EnumDescriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{1}
+}
+
+// Sort direction
+type OrderDirection int32
+
+const (
+	OrderDirection_ORDER_DIRECTION_UNSPECIFIED OrderDirection = 0
+	OrderDirection_ORDER_DIRECTION_ASC         OrderDirection = 1
+	OrderDirection_ORDER_DIRECTION_DESC        OrderDirection = 2
+)
+
+// Enum value maps for OrderDirection.
+var (
+	OrderDirection_name = map[int32]string{
+		0: "ORDER_DIRECTION_UNSPECIFIED",
+		1: "ORDER_DIRECTION_ASC",
+		2: "ORDER_DIRECTION_DESC",
+	}
+	OrderDirection_value = map[string]int32{
+		"ORDER_DIRECTION_UNSPECIFIED": 0,
+		"ORDER_DIRECTION_ASC":         1,
+		"ORDER_DIRECTION_DESC":        2,
+	}
+)
+
+func (x OrderDirection) Enum() *OrderDirection {
+	p := new(OrderDirection)
+	*p = x
+	return p
+}
+
+func (x OrderDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_generated_service_proto_enumTypes[2].Descriptor()
+}
+
+func (OrderDirection) Type() protoreflect.EnumType {
+	return &file_generated_service_proto_enumTypes[2]
+}
+
+func (x OrderDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderDirection.Descriptor instead.
+func (OrderDirection) EnumDescriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{2}
+}
+
+// Types that can be searched
+type SearchableType int32
+
+const (
+	SearchableType_SEARCHABLE_TYPE_UNSPECIFIED SearchableType = 0
+	SearchableType_SEARCHABLE_TYPE_USER        SearchableType = 1
+	SearchableType_SEARCHABLE_TYPE_POST        SearchableType = 2
+	SearchableType_SEARCHABLE_TYPE_COMMENT     SearchableType = 3
+)
+
+// Enum value maps for SearchableType.
+var (
+	SearchableType_name = map[int32]string{
+		0: "SEARCHABLE_TYPE_UNSPECIFIED",
+		1: "SEARCHABLE_TYPE_USER",
+		2: "SEARCHABLE_TYPE_POST",
+		3: "SEARCHABLE_TYPE_COMMENT",
+	}
+	SearchableType_value = map[string]int32{
+		"SEARCHABLE_TYPE_UNSPECIFIED": 0,
+		"SEARCHABLE_TYPE_USER":        1,
+		"SEARCHABLE_TYPE_POST":        2,
+		"SEARCHABLE_TYPE_COMMENT":     3,
+	}
+)
+
+func (x SearchableType) Enum() *SearchableType {
+	p := new(SearchableType)
+	*p = x
+	return p
+}
+
+func (x SearchableType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchableType) Descriptor() protoreflect.EnumDescriptor {
+	return file_generated_service_proto_enumTypes[3].Descriptor()
+}
+
+func (SearchableType) Type() protoreflect.EnumType {
+	return &file_generated_service_proto_enumTypes[3]
+}
+
+func (x SearchableType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchableType.Descriptor instead.
+func (SearchableType) EnumDescriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{3}
+}
+
 // UI theme options
 type Theme int32
 
@@ -58,11 +276,11 @@ func (x Theme) String() string {
 }
 
 func (Theme) Descriptor() protoreflect.EnumDescriptor {
-	return file_generated_service_proto_enumTypes[0].Descriptor()
+	return file_generated_service_proto_enumTypes[4].Descriptor()
 }
 
 func (Theme) Type() protoreflect.EnumType {
-	return &file_generated_service_proto_enumTypes[0]
+	return &file_generated_service_proto_enumTypes[4]
 }
 
 func (x Theme) Number() protoreflect.EnumNumber {
@@ -71,7 +289,7 @@ func (x Theme) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Theme.Descriptor instead.
 func (Theme) EnumDescriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{0}
+	return file_generated_service_proto_rawDescGZIP(), []int{4}
 }
 
 type UserRole int32
@@ -110,11 +328,11 @@ func (x UserRole) String() string {
 }
 
 func (UserRole) Descriptor() protoreflect.EnumDescriptor {
-	return file_generated_service_proto_enumTypes[1].Descriptor()
+	return file_generated_service_proto_enumTypes[5].Descriptor()
 }
 
 func (UserRole) Type() protoreflect.EnumType {
-	return &file_generated_service_proto_enumTypes[1]
+	return &file_generated_service_proto_enumTypes[5]
 }
 
 func (x UserRole) Number() protoreflect.EnumNumber {
@@ -123,31 +341,261 @@ func (x UserRole) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserRole.Descriptor instead.
 func (UserRole) EnumDescriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{1}
+	return file_generated_service_proto_rawDescGZIP(), []int{5}
 }
 
-// Wrapper message for a list of String.
-type ListOfListOfString struct {
+// Profile fields that an update can set to null
+type NullableProfileField int32
+
+const (
+	NullableProfileField_NULLABLE_PROFILE_FIELD_UNSPECIFIED  NullableProfileField = 0
+	NullableProfileField_NULLABLE_PROFILE_FIELD_DISPLAY_NAME NullableProfileField = 1
+	NullableProfileField_NULLABLE_PROFILE_FIELD_TIMEZONE     NullableProfileField = 2
+	NullableProfileField_NULLABLE_PROFILE_FIELD_THEME        NullableProfileField = 3
+)
+
+// Enum value maps for NullableProfileField.
+var (
+	NullableProfileField_name = map[int32]string{
+		0: "NULLABLE_PROFILE_FIELD_UNSPECIFIED",
+		1: "NULLABLE_PROFILE_FIELD_DISPLAY_NAME",
+		2: "NULLABLE_PROFILE_FIELD_TIMEZONE",
+		3: "NULLABLE_PROFILE_FIELD_THEME",
+	}
+	NullableProfileField_value = map[string]int32{
+		"NULLABLE_PROFILE_FIELD_UNSPECIFIED":  0,
+		"NULLABLE_PROFILE_FIELD_DISPLAY_NAME": 1,
+		"NULLABLE_PROFILE_FIELD_TIMEZONE":     2,
+		"NULLABLE_PROFILE_FIELD_THEME":        3,
+	}
+)
+
+func (x NullableProfileField) Enum() *NullableProfileField {
+	p := new(NullableProfileField)
+	*p = x
+	return p
+}
+
+func (x NullableProfileField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NullableProfileField) Descriptor() protoreflect.EnumDescriptor {
+	return file_generated_service_proto_enumTypes[6].Descriptor()
+}
+
+func (NullableProfileField) Type() protoreflect.EnumType {
+	return &file_generated_service_proto_enumTypes[6]
+}
+
+func (x NullableProfileField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NullableProfileField.Descriptor instead.
+func (NullableProfileField) EnumDescriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{6}
+}
+
+// Kinds of changes recorded in the audit log
+type AuditAction int32
+
+const (
+	AuditAction_AUDIT_ACTION_UNSPECIFIED    AuditAction = 0
+	AuditAction_AUDIT_ACTION_CREATE_USER    AuditAction = 1
+	AuditAction_AUDIT_ACTION_UPDATE_USER    AuditAction = 2
+	AuditAction_AUDIT_ACTION_DELETE_USER    AuditAction = 3
+	AuditAction_AUDIT_ACTION_CREATE_POST    AuditAction = 4
+	AuditAction_AUDIT_ACTION_RESTORE_USER   AuditAction = 5
+	AuditAction_AUDIT_ACTION_CREATE_COMMENT AuditAction = 6
+	AuditAction_AUDIT_ACTION_UPDATE_COMMENT AuditAction = 7
+	AuditAction_AUDIT_ACTION_DELETE_COMMENT AuditAction = 8
+	AuditAction_AUDIT_ACTION_UPDATE_POST    AuditAction = 9
+	AuditAction_AUDIT_ACTION_DELETE_POST    AuditAction = 10
+)
+
+// Enum value maps for AuditAction.
+var (
+	AuditAction_name = map[int32]string{
+		0:  "AUDIT_ACTION_UNSPECIFIED",
+		1:  "AUDIT_ACTION_CREATE_USER",
+		2:  "AUDIT_ACTION_UPDATE_USER",
+		3:  "AUDIT_ACTION_DELETE_USER",
+		4:  "AUDIT_ACTION_CREATE_POST",
+		5:  "AUDIT_ACTION_RESTORE_USER",
+		6:  "AUDIT_ACTION_CREATE_COMMENT",
+		7:  "AUDIT_ACTION_UPDATE_COMMENT",
+		8:  "AUDIT_ACTION_DELETE_COMMENT",
+		9:  "AUDIT_ACTION_UPDATE_POST",
+		10: "AUDIT_ACTION_DELETE_POST",
+	}
+	AuditAction_value = map[string]int32{
+		"AUDIT_ACTION_UNSPECIFIED":    0,
+		"AUDIT_ACTION_CREATE_USER":    1,
+		"AUDIT_ACTION_UPDATE_USER":    2,
+		"AUDIT_ACTION_DELETE_USER":    3,
+		"AUDIT_ACTION_CREATE_POST":    4,
+		"AUDIT_ACTION_RESTORE_USER":   5,
+		"AUDIT_ACTION_CREATE_COMMENT": 6,
+		"AUDIT_ACTION_UPDATE_COMMENT": 7,
+		"AUDIT_ACTION_DELETE_COMMENT": 8,
+		"AUDIT_ACTION_UPDATE_POST":    9,
+		"AUDIT_ACTION_DELETE_POST":    10,
+	}
+)
+
+func (x AuditAction) Enum() *AuditAction {
+	p := new(AuditAction)
+	*p = x
+	return p
+}
+
+func (x AuditAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_generated_service_proto_enumTypes[7].Descriptor()
+}
+
+func (AuditAction) Type() protoreflect.EnumType {
+	return &file_generated_service_proto_enumTypes[7]
+}
+
+func (x AuditAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditAction.Descriptor instead.
+func (AuditAction) EnumDescriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{7}
+}
+
+// Kinds of activity items
+type ActivityType int32
+
+const (
+	ActivityType_ACTIVITY_TYPE_UNSPECIFIED ActivityType = 0
+	ActivityType_ACTIVITY_TYPE_POST        ActivityType = 1
+	ActivityType_ACTIVITY_TYPE_COMMENT     ActivityType = 2
+)
+
+// Enum value maps for ActivityType.
+var (
+	ActivityType_name = map[int32]string{
+		0: "ACTIVITY_TYPE_UNSPECIFIED",
+		1: "ACTIVITY_TYPE_POST",
+		2: "ACTIVITY_TYPE_COMMENT",
+	}
+	ActivityType_value = map[string]int32{
+		"ACTIVITY_TYPE_UNSPECIFIED": 0,
+		"ACTIVITY_TYPE_POST":        1,
+		"ACTIVITY_TYPE_COMMENT":     2,
+	}
+)
+
+func (x ActivityType) Enum() *ActivityType {
+	p := new(ActivityType)
+	*p = x
+	return p
+}
+
+func (x ActivityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_generated_service_proto_enumTypes[8].Descriptor()
+}
+
+func (ActivityType) Type() protoreflect.EnumType {
+	return &file_generated_service_proto_enumTypes[8]
+}
+
+func (x ActivityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityType.Descriptor instead.
+func (ActivityType) EnumDescriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{8}
+}
+
+// Publication state of a post
+type PostStatus int32
+
+const (
+	PostStatus_POST_STATUS_UNSPECIFIED PostStatus = 0
+	PostStatus_POST_STATUS_DRAFT       PostStatus = 1
+	PostStatus_POST_STATUS_PUBLISHED   PostStatus = 2
+	PostStatus_POST_STATUS_ARCHIVED    PostStatus = 3
+)
+
+// Enum value maps for PostStatus.
+var (
+	PostStatus_name = map[int32]string{
+		0: "POST_STATUS_UNSPECIFIED",
+		1: "POST_STATUS_DRAFT",
+		2: "POST_STATUS_PUBLISHED",
+		3: "POST_STATUS_ARCHIVED",
+	}
+	PostStatus_value = map[string]int32{
+		"POST_STATUS_UNSPECIFIED": 0,
+		"POST_STATUS_DRAFT":       1,
+		"POST_STATUS_PUBLISHED":   2,
+		"POST_STATUS_ARCHIVED":    3,
+	}
+)
+
+func (x PostStatus) Enum() *PostStatus {
+	p := new(PostStatus)
+	*p = x
+	return p
+}
+
+func (x PostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_generated_service_proto_enumTypes[9].Descriptor()
+}
+
+func (PostStatus) Type() protoreflect.EnumType {
+	return &file_generated_service_proto_enumTypes[9]
+}
+
+func (x PostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostStatus.Descriptor instead.
+func (PostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{9}
+}
+
+// Wrapper message for a list of ActivityType.
+type ListOfActivityType struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
-	List          *ListOfListOfString_List `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	List          *ListOfActivityType_List `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOfListOfString) Reset() {
-	*x = ListOfListOfString{}
+func (x *ListOfActivityType) Reset() {
+	*x = ListOfActivityType{}
 	mi := &file_generated_service_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOfListOfString) String() string {
+func (x *ListOfActivityType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOfListOfString) ProtoMessage() {}
+func (*ListOfActivityType) ProtoMessage() {}
 
-func (x *ListOfListOfString) ProtoReflect() protoreflect.Message {
+func (x *ListOfActivityType) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -159,12 +607,12 @@ func (x *ListOfListOfString) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOfListOfString.ProtoReflect.Descriptor instead.
-func (*ListOfListOfString) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOfActivityType.ProtoReflect.Descriptor instead.
+func (*ListOfActivityType) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ListOfListOfString) GetList() *ListOfListOfString_List {
+func (x *ListOfActivityType) GetList() *ListOfActivityType_List {
 	if x != nil {
 		return x.List
 	}
@@ -172,27 +620,27 @@ func (x *ListOfListOfString) GetList() *ListOfListOfString_List {
 }
 
 // Wrapper message for a list of String.
-type ListOfString struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          *ListOfString_List     `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+type ListOfListOfString struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	List          *ListOfListOfString_List `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOfString) Reset() {
-	*x = ListOfString{}
+func (x *ListOfListOfString) Reset() {
+	*x = ListOfListOfString{}
 	mi := &file_generated_service_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOfString) String() string {
+func (x *ListOfListOfString) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOfString) ProtoMessage() {}
+func (*ListOfListOfString) ProtoMessage() {}
 
-func (x *ListOfString) ProtoReflect() protoreflect.Message {
+func (x *ListOfListOfString) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -204,41 +652,40 @@ func (x *ListOfString) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOfString.ProtoReflect.Descriptor instead.
-func (*ListOfString) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOfListOfString.ProtoReflect.Descriptor instead.
+func (*ListOfListOfString) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ListOfString) GetList() *ListOfString_List {
+func (x *ListOfListOfString) GetList() *ListOfListOfString_List {
 	if x != nil {
 		return x.List
 	}
 	return nil
 }
 
-// Key message for User entity lookup
-type LookupUserByIdRequestKey struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Key field for User entity lookup.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// Wrapper message for a list of NullableProfileField.
+type ListOfNullableProfileField struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	List          *ListOfNullableProfileField_List `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LookupUserByIdRequestKey) Reset() {
-	*x = LookupUserByIdRequestKey{}
+func (x *ListOfNullableProfileField) Reset() {
+	*x = ListOfNullableProfileField{}
 	mi := &file_generated_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LookupUserByIdRequestKey) String() string {
+func (x *ListOfNullableProfileField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupUserByIdRequestKey) ProtoMessage() {}
+func (*ListOfNullableProfileField) ProtoMessage() {}
 
-func (x *LookupUserByIdRequestKey) ProtoReflect() protoreflect.Message {
+func (x *ListOfNullableProfileField) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -250,42 +697,40 @@ func (x *LookupUserByIdRequestKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupUserByIdRequestKey.ProtoReflect.Descriptor instead.
-func (*LookupUserByIdRequestKey) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOfNullableProfileField.ProtoReflect.Descriptor instead.
+func (*ListOfNullableProfileField) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *LookupUserByIdRequestKey) GetId() string {
+func (x *ListOfNullableProfileField) GetList() *ListOfNullableProfileField_List {
 	if x != nil {
-		return x.Id
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-// Request message for User entity lookup.
-type LookupUserByIdRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of keys to look up User entities.
-	// Order matters - each key maps to one entity in LookupUserByIdResponse.
-	Keys          []*LookupUserByIdRequestKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+// Wrapper message for a list of NullableUserField.
+type ListOfNullableUserField struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	List          *ListOfNullableUserField_List `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LookupUserByIdRequest) Reset() {
-	*x = LookupUserByIdRequest{}
+func (x *ListOfNullableUserField) Reset() {
+	*x = ListOfNullableUserField{}
 	mi := &file_generated_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LookupUserByIdRequest) String() string {
+func (x *ListOfNullableUserField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupUserByIdRequest) ProtoMessage() {}
+func (*ListOfNullableUserField) ProtoMessage() {}
 
-func (x *LookupUserByIdRequest) ProtoReflect() protoreflect.Message {
+func (x *ListOfNullableUserField) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -297,53 +742,40 @@ func (x *LookupUserByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupUserByIdRequest.ProtoReflect.Descriptor instead.
-func (*LookupUserByIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOfNullableUserField.ProtoReflect.Descriptor instead.
+func (*ListOfNullableUserField) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LookupUserByIdRequest) GetKeys() []*LookupUserByIdRequestKey {
+func (x *ListOfNullableUserField) GetList() *ListOfNullableUserField_List {
 	if x != nil {
-		return x.Keys
+		return x.List
 	}
 	return nil
 }
 
-// Response message for User entity lookup.
-type LookupUserByIdResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of User entities in the same order as the keys in LookupUserByIdRequest.
-	// Always return the same number of entities as keys. Use null for entities that cannot be found.
-	//
-	// Example:
-	//
-	//	LookupUserByIdRequest:
-	//	  keys:
-	//	    - id: 1
-	//	    - id: 2
-	//	LookupUserByIdResponse:
-	//	  result:
-	//	    - id: 1 # User with id 1 found
-	//	    - null  # User with id 2 not found
-	Result        []*User `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+// Wrapper message for a list of PostStatus.
+type ListOfPostStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *ListOfPostStatus_List `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LookupUserByIdResponse) Reset() {
-	*x = LookupUserByIdResponse{}
+func (x *ListOfPostStatus) Reset() {
+	*x = ListOfPostStatus{}
 	mi := &file_generated_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LookupUserByIdResponse) String() string {
+func (x *ListOfPostStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupUserByIdResponse) ProtoMessage() {}
+func (*ListOfPostStatus) ProtoMessage() {}
 
-func (x *LookupUserByIdResponse) ProtoReflect() protoreflect.Message {
+func (x *ListOfPostStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -355,39 +787,40 @@ func (x *LookupUserByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupUserByIdResponse.ProtoReflect.Descriptor instead.
-func (*LookupUserByIdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOfPostStatus.ProtoReflect.Descriptor instead.
+func (*ListOfPostStatus) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *LookupUserByIdResponse) GetResult() []*User {
+func (x *ListOfPostStatus) GetList() *ListOfPostStatus_List {
 	if x != nil {
-		return x.Result
+		return x.List
 	}
 	return nil
 }
 
-// Request message for users operation: Returns a list of all internal users.
-type QueryUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// Wrapper message for a list of SearchableType.
+type ListOfSearchableType struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	List          *ListOfSearchableType_List `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryUsersRequest) Reset() {
-	*x = QueryUsersRequest{}
+func (x *ListOfSearchableType) Reset() {
+	*x = ListOfSearchableType{}
 	mi := &file_generated_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryUsersRequest) String() string {
+func (x *ListOfSearchableType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryUsersRequest) ProtoMessage() {}
+func (*ListOfSearchableType) ProtoMessage() {}
 
-func (x *QueryUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListOfSearchableType) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -399,34 +832,40 @@ func (x *QueryUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryUsersRequest.ProtoReflect.Descriptor instead.
-func (*QueryUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOfSearchableType.ProtoReflect.Descriptor instead.
+func (*ListOfSearchableType) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{5}
 }
 
-// Response message for users operation: Returns a list of all internal users.
-type QueryUsersResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Returns a list of all internal users
-	Users         []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+func (x *ListOfSearchableType) GetList() *ListOfSearchableType_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// Wrapper message for a list of String.
+type ListOfString struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *ListOfString_List     `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryUsersResponse) Reset() {
-	*x = QueryUsersResponse{}
+func (x *ListOfString) Reset() {
+	*x = ListOfString{}
 	mi := &file_generated_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryUsersResponse) String() string {
+func (x *ListOfString) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryUsersResponse) ProtoMessage() {}
+func (*ListOfString) ProtoMessage() {}
 
-func (x *QueryUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *ListOfString) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -438,40 +877,40 @@ func (x *QueryUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryUsersResponse.ProtoReflect.Descriptor instead.
-func (*QueryUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOfString.ProtoReflect.Descriptor instead.
+func (*ListOfString) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *QueryUsersResponse) GetUsers() []*User {
+func (x *ListOfString) GetList() *ListOfString_List {
 	if x != nil {
-		return x.Users
+		return x.List
 	}
 	return nil
 }
 
-// Request message for user operation: Returns a single internal user by ID.
-type QueryUserRequest struct {
+// Wrapper message for a list of UserFilter.
+type ListOfUserFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	List          *ListOfUserFilter_List `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryUserRequest) Reset() {
-	*x = QueryUserRequest{}
+func (x *ListOfUserFilter) Reset() {
+	*x = ListOfUserFilter{}
 	mi := &file_generated_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryUserRequest) String() string {
+func (x *ListOfUserFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryUserRequest) ProtoMessage() {}
+func (*ListOfUserFilter) ProtoMessage() {}
 
-func (x *QueryUserRequest) ProtoReflect() protoreflect.Message {
+func (x *ListOfUserFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -483,41 +922,40 @@ func (x *QueryUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryUserRequest.ProtoReflect.Descriptor instead.
-func (*QueryUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOfUserFilter.ProtoReflect.Descriptor instead.
+func (*ListOfUserFilter) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *QueryUserRequest) GetId() string {
+func (x *ListOfUserFilter) GetList() *ListOfUserFilter_List {
 	if x != nil {
-		return x.Id
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-// Response message for user operation: Returns a single internal user by ID.
-type QueryUserResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Returns a single internal user by ID
-	User          *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+// Wrapper message for a list of UserOrder.
+type ListOfUserOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *ListOfUserOrder_List  `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryUserResponse) Reset() {
-	*x = QueryUserResponse{}
+func (x *ListOfUserOrder) Reset() {
+	*x = ListOfUserOrder{}
 	mi := &file_generated_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryUserResponse) String() string {
+func (x *ListOfUserOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryUserResponse) ProtoMessage() {}
+func (*ListOfUserOrder) ProtoMessage() {}
 
-func (x *QueryUserResponse) ProtoReflect() protoreflect.Message {
+func (x *ListOfUserOrder) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -529,39 +967,40 @@ func (x *QueryUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryUserResponse.ProtoReflect.Descriptor instead.
-func (*QueryUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOfUserOrder.ProtoReflect.Descriptor instead.
+func (*ListOfUserOrder) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *QueryUserResponse) GetUser() *User {
+func (x *ListOfUserOrder) GetList() *ListOfUserOrder_List {
 	if x != nil {
-		return x.User
+		return x.List
 	}
 	return nil
 }
 
-// Request message for externalUsers operation: Returns a list of all external users.
-type QueryExternalUsersRequest struct {
+// Wrapper message for a list of UserRole.
+type ListOfUserRole struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *ListOfUserRole_List   `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryExternalUsersRequest) Reset() {
-	*x = QueryExternalUsersRequest{}
+func (x *ListOfUserRole) Reset() {
+	*x = ListOfUserRole{}
 	mi := &file_generated_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryExternalUsersRequest) String() string {
+func (x *ListOfUserRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryExternalUsersRequest) ProtoMessage() {}
+func (*ListOfUserRole) ProtoMessage() {}
 
-func (x *QueryExternalUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListOfUserRole) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -573,34 +1012,41 @@ func (x *QueryExternalUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryExternalUsersRequest.ProtoReflect.Descriptor instead.
-func (*QueryExternalUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOfUserRole.ProtoReflect.Descriptor instead.
+func (*ListOfUserRole) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{9}
 }
 
-// Response message for externalUsers operation: Returns a list of all external users.
-type QueryExternalUsersResponse struct {
+func (x *ListOfUserRole) GetList() *ListOfUserRole_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// Key message for User entity lookup
+type LookupUserByIdRequestKey struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Returns a list of all external users
-	ExternalUsers []*ExternalUser `protobuf:"bytes,1,rep,name=external_users,json=externalUsers,proto3" json:"external_users,omitempty"`
+	// Key field for User entity lookup.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryExternalUsersResponse) Reset() {
-	*x = QueryExternalUsersResponse{}
+func (x *LookupUserByIdRequestKey) Reset() {
+	*x = LookupUserByIdRequestKey{}
 	mi := &file_generated_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryExternalUsersResponse) String() string {
+func (x *LookupUserByIdRequestKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryExternalUsersResponse) ProtoMessage() {}
+func (*LookupUserByIdRequestKey) ProtoMessage() {}
 
-func (x *QueryExternalUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *LookupUserByIdRequestKey) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -612,40 +1058,42 @@ func (x *QueryExternalUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryExternalUsersResponse.ProtoReflect.Descriptor instead.
-func (*QueryExternalUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LookupUserByIdRequestKey.ProtoReflect.Descriptor instead.
+func (*LookupUserByIdRequestKey) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryExternalUsersResponse) GetExternalUsers() []*ExternalUser {
+func (x *LookupUserByIdRequestKey) GetId() string {
 	if x != nil {
-		return x.ExternalUsers
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// Request message for externalUser operation: Returns a single external user by ID.
-type QueryExternalUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// Request message for User entity lookup.
+type LookupUserByIdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of keys to look up User entities.
+	// Order matters - each key maps to one entity in LookupUserByIdResponse.
+	Keys          []*LookupUserByIdRequestKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryExternalUserRequest) Reset() {
-	*x = QueryExternalUserRequest{}
+func (x *LookupUserByIdRequest) Reset() {
+	*x = LookupUserByIdRequest{}
 	mi := &file_generated_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryExternalUserRequest) String() string {
+func (x *LookupUserByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryExternalUserRequest) ProtoMessage() {}
+func (*LookupUserByIdRequest) ProtoMessage() {}
 
-func (x *QueryExternalUserRequest) ProtoReflect() protoreflect.Message {
+func (x *LookupUserByIdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_generated_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -657,42 +1105,4303 @@ func (x *QueryExternalUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryExternalUserRequest.ProtoReflect.Descriptor instead.
-func (*QueryExternalUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LookupUserByIdRequest.ProtoReflect.Descriptor instead.
+func (*LookupUserByIdRequest) Descriptor() ([]byte, []int) {
 	return file_generated_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *QueryExternalUserRequest) GetId() string {
+func (x *LookupUserByIdRequest) GetKeys() []*LookupUserByIdRequestKey {
 	if x != nil {
-		return x.Id
+		return x.Keys
 	}
-	return ""
+	return nil
 }
 
-// Response message for externalUser operation: Returns a single external user by ID.
-type QueryExternalUserResponse struct {
+// Response message for User entity lookup.
+type LookupUserByIdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of User entities in the same order as the keys in LookupUserByIdRequest.
+	// Always return the same number of entities as keys. Use null for entities that cannot be found.
+	//
+	// Example:
+	//
+	//	LookupUserByIdRequest:
+	//	  keys:
+	//	    - id: 1
+	//	    - id: 2
+	//	LookupUserByIdResponse:
+	//	  result:
+	//	    - id: 1 # User with id 1 found
+	//	    - null  # User with id 2 not found
+	Result        []*User `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupUserByIdResponse) Reset() {
+	*x = LookupUserByIdResponse{}
+	mi := &file_generated_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupUserByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupUserByIdResponse) ProtoMessage() {}
+
+func (x *LookupUserByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupUserByIdResponse.ProtoReflect.Descriptor instead.
+func (*LookupUserByIdResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LookupUserByIdResponse) GetResult() []*User {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Key message for Post entity lookup
+type LookupPostByIdRequestKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Key field for Post entity lookup.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupPostByIdRequestKey) Reset() {
+	*x = LookupPostByIdRequestKey{}
+	mi := &file_generated_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupPostByIdRequestKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupPostByIdRequestKey) ProtoMessage() {}
+
+func (x *LookupPostByIdRequestKey) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupPostByIdRequestKey.ProtoReflect.Descriptor instead.
+func (*LookupPostByIdRequestKey) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LookupPostByIdRequestKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request message for Post entity lookup.
+type LookupPostByIdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of keys to look up Post entities.
+	// Order matters - each key maps to one entity in LookupPostByIdResponse.
+	Keys          []*LookupPostByIdRequestKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupPostByIdRequest) Reset() {
+	*x = LookupPostByIdRequest{}
+	mi := &file_generated_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupPostByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupPostByIdRequest) ProtoMessage() {}
+
+func (x *LookupPostByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupPostByIdRequest.ProtoReflect.Descriptor instead.
+func (*LookupPostByIdRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LookupPostByIdRequest) GetKeys() []*LookupPostByIdRequestKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// Response message for Post entity lookup.
+type LookupPostByIdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of Post entities in the same order as the keys in LookupPostByIdRequest.
+	// Always return the same number of entities as keys. Use null for entities that cannot be found.
+	//
+	// Example:
+	//
+	//	LookupPostByIdRequest:
+	//	  keys:
+	//	    - id: 1
+	//	    - id: 2
+	//	LookupPostByIdResponse:
+	//	  result:
+	//	    - id: 1 # Post with id 1 found
+	//	    - null  # Post with id 2 not found
+	Result        []*Post `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupPostByIdResponse) Reset() {
+	*x = LookupPostByIdResponse{}
+	mi := &file_generated_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupPostByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupPostByIdResponse) ProtoMessage() {}
+
+func (x *LookupPostByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupPostByIdResponse.ProtoReflect.Descriptor instead.
+func (*LookupPostByIdResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LookupPostByIdResponse) GetResult() []*Post {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Key message for Comment entity lookup
+type LookupCommentByIdRequestKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Key field for Comment entity lookup.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupCommentByIdRequestKey) Reset() {
+	*x = LookupCommentByIdRequestKey{}
+	mi := &file_generated_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupCommentByIdRequestKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupCommentByIdRequestKey) ProtoMessage() {}
+
+func (x *LookupCommentByIdRequestKey) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupCommentByIdRequestKey.ProtoReflect.Descriptor instead.
+func (*LookupCommentByIdRequestKey) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LookupCommentByIdRequestKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request message for Comment entity lookup.
+type LookupCommentByIdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of keys to look up Comment entities.
+	// Order matters - each key maps to one entity in LookupCommentByIdResponse.
+	Keys          []*LookupCommentByIdRequestKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupCommentByIdRequest) Reset() {
+	*x = LookupCommentByIdRequest{}
+	mi := &file_generated_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupCommentByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupCommentByIdRequest) ProtoMessage() {}
+
+func (x *LookupCommentByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupCommentByIdRequest.ProtoReflect.Descriptor instead.
+func (*LookupCommentByIdRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LookupCommentByIdRequest) GetKeys() []*LookupCommentByIdRequestKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// Response message for Comment entity lookup.
+type LookupCommentByIdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of Comment entities in the same order as the keys in LookupCommentByIdRequest.
+	// Always return the same number of entities as keys. Use null for entities that cannot be found.
+	//
+	// Example:
+	//
+	//	LookupCommentByIdRequest:
+	//	  keys:
+	//	    - id: 1
+	//	    - id: 2
+	//	LookupCommentByIdResponse:
+	//	  result:
+	//	    - id: 1 # Comment with id 1 found
+	//	    - null  # Comment with id 2 not found
+	Result        []*Comment `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupCommentByIdResponse) Reset() {
+	*x = LookupCommentByIdResponse{}
+	mi := &file_generated_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupCommentByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupCommentByIdResponse) ProtoMessage() {}
+
+func (x *LookupCommentByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupCommentByIdResponse.ProtoReflect.Descriptor instead.
+func (*LookupCommentByIdResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LookupCommentByIdResponse) GetResult() []*Comment {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Request message for users operation: Returns a list of all internal users.
+type QueryUsersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderBy        *ListOfUserOrder       `protobuf:"bytes,1,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter         *UserFilter            `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	IncludeDeleted *wrapperspb.BoolValue  `protobuf:"bytes,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueryUsersRequest) Reset() {
+	*x = QueryUsersRequest{}
+	mi := &file_generated_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUsersRequest) ProtoMessage() {}
+
+func (x *QueryUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUsersRequest.ProtoReflect.Descriptor instead.
+func (*QueryUsersRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueryUsersRequest) GetOrderBy() *ListOfUserOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
+func (x *QueryUsersRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *QueryUsersRequest) GetIncludeDeleted() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return nil
+}
+
+// Response message for users operation: Returns a list of all internal users.
+type QueryUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Returns a list of all internal users
+	Users         []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryUsersResponse) Reset() {
+	*x = QueryUsersResponse{}
+	mi := &file_generated_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUsersResponse) ProtoMessage() {}
+
+func (x *QueryUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUsersResponse.ProtoReflect.Descriptor instead.
+func (*QueryUsersResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueryUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Request message for user operation: Returns a single internal user by ID.
+type QueryUserRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted *wrapperspb.BoolValue  `protobuf:"bytes,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueryUserRequest) Reset() {
+	*x = QueryUserRequest{}
+	mi := &file_generated_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUserRequest) ProtoMessage() {}
+
+func (x *QueryUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUserRequest.ProtoReflect.Descriptor instead.
+func (*QueryUserRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QueryUserRequest) GetIncludeDeleted() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return nil
+}
+
+// Response message for user operation: Returns a single internal user by ID.
+type QueryUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Returns a single internal user by ID
+	User          *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryUserResponse) Reset() {
+	*x = QueryUserResponse{}
+	mi := &file_generated_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUserResponse) ProtoMessage() {}
+
+func (x *QueryUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUserResponse.ProtoReflect.Descriptor instead.
+func (*QueryUserResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QueryUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Request message for externalUsers operation: Returns a list of all external users.
+type QueryExternalUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryExternalUsersRequest) Reset() {
+	*x = QueryExternalUsersRequest{}
+	mi := &file_generated_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryExternalUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExternalUsersRequest) ProtoMessage() {}
+
+func (x *QueryExternalUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryExternalUsersRequest.ProtoReflect.Descriptor instead.
+func (*QueryExternalUsersRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{23}
+}
+
+// Response message for externalUsers operation: Returns a list of all external users.
+type QueryExternalUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Returns a list of all external users
+	ExternalUsers []*ExternalUser `protobuf:"bytes,1,rep,name=external_users,json=externalUsers,proto3" json:"external_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryExternalUsersResponse) Reset() {
+	*x = QueryExternalUsersResponse{}
+	mi := &file_generated_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryExternalUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExternalUsersResponse) ProtoMessage() {}
+
+func (x *QueryExternalUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryExternalUsersResponse.ProtoReflect.Descriptor instead.
+func (*QueryExternalUsersResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QueryExternalUsersResponse) GetExternalUsers() []*ExternalUser {
+	if x != nil {
+		return x.ExternalUsers
+	}
+	return nil
+}
+
+// Request message for externalUser operation: Returns a single external user by ID.
+type QueryExternalUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryExternalUserRequest) Reset() {
+	*x = QueryExternalUserRequest{}
+	mi := &file_generated_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryExternalUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExternalUserRequest) ProtoMessage() {}
+
+func (x *QueryExternalUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryExternalUserRequest.ProtoReflect.Descriptor instead.
+func (*QueryExternalUserRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *QueryExternalUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for externalUser operation: Returns a single external user by ID.
+type QueryExternalUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Returns a single external user by ID
+	ExternalUser  *ExternalUser `protobuf:"bytes,1,opt,name=external_user,json=externalUser,proto3" json:"external_user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryExternalUserResponse) Reset() {
+	*x = QueryExternalUserResponse{}
+	mi := &file_generated_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryExternalUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExternalUserResponse) ProtoMessage() {}
+
+func (x *QueryExternalUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryExternalUserResponse.ProtoReflect.Descriptor instead.
+func (*QueryExternalUserResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QueryExternalUserResponse) GetExternalUser() *ExternalUser {
+	if x != nil {
+		return x.ExternalUser
+	}
+	return nil
+}
+
+// Request message for userActivity operation: Returns the activity items of a user, most recently created first. since and until are RFC 3339 times that limit the items to those created at or after since and before until.
+type QueryUserActivityRequest struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	UserId         string                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit          *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeDeleted *wrapperspb.BoolValue   `protobuf:"bytes,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	Since          *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until          *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueryUserActivityRequest) Reset() {
+	*x = QueryUserActivityRequest{}
+	mi := &file_generated_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUserActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUserActivityRequest) ProtoMessage() {}
+
+func (x *QueryUserActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUserActivityRequest.ProtoReflect.Descriptor instead.
+func (*QueryUserActivityRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QueryUserActivityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QueryUserActivityRequest) GetLimit() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *QueryUserActivityRequest) GetIncludeDeleted() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return nil
+}
+
+func (x *QueryUserActivityRequest) GetSince() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *QueryUserActivityRequest) GetUntil() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+// Response message for userActivity operation: Returns the activity items of a user, most recently created first. since and until are RFC 3339 times that limit the items to those created at or after since and before until.
+type QueryUserActivityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Returns the activity items of a user, most recently created first. since and until are RFC 3339 times that limit the items to those created at or after since and before until.
+	UserActivity  []*ActivityItem `protobuf:"bytes,1,rep,name=user_activity,json=userActivity,proto3" json:"user_activity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryUserActivityResponse) Reset() {
+	*x = QueryUserActivityResponse{}
+	mi := &file_generated_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUserActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUserActivityResponse) ProtoMessage() {}
+
+func (x *QueryUserActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUserActivityResponse.ProtoReflect.Descriptor instead.
+func (*QueryUserActivityResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *QueryUserActivityResponse) GetUserActivity() []*ActivityItem {
+	if x != nil {
+		return x.UserActivity
+	}
+	return nil
+}
+
+// Request message for usersConnection operation: Returns a page of internal users.
+type QueryUsersConnectionRequest struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	First          *wrapperspb.Int32Value  `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	After          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	Last           *wrapperspb.Int32Value  `protobuf:"bytes,3,opt,name=last,proto3" json:"last,omitempty"`
+	Before         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	OrderBy        *ListOfUserOrder        `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter         *UserFilter             `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	IncludeDeleted *wrapperspb.BoolValue   `protobuf:"bytes,7,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueryUsersConnectionRequest) Reset() {
+	*x = QueryUsersConnectionRequest{}
+	mi := &file_generated_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUsersConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUsersConnectionRequest) ProtoMessage() {}
+
+func (x *QueryUsersConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUsersConnectionRequest.ProtoReflect.Descriptor instead.
+func (*QueryUsersConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *QueryUsersConnectionRequest) GetFirst() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *QueryUsersConnectionRequest) GetAfter() *wrapperspb.StringValue {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *QueryUsersConnectionRequest) GetLast() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Last
+	}
+	return nil
+}
+
+func (x *QueryUsersConnectionRequest) GetBefore() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *QueryUsersConnectionRequest) GetOrderBy() *ListOfUserOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
+func (x *QueryUsersConnectionRequest) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *QueryUsersConnectionRequest) GetIncludeDeleted() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return nil
+}
+
+// Response message for usersConnection operation: Returns a page of internal users.
+type QueryUsersConnectionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Returns a page of internal users
+	UsersConnection *UserConnection `protobuf:"bytes,1,opt,name=users_connection,json=usersConnection,proto3" json:"users_connection,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QueryUsersConnectionResponse) Reset() {
+	*x = QueryUsersConnectionResponse{}
+	mi := &file_generated_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUsersConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUsersConnectionResponse) ProtoMessage() {}
+
+func (x *QueryUsersConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUsersConnectionResponse.ProtoReflect.Descriptor instead.
+func (*QueryUsersConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *QueryUsersConnectionResponse) GetUsersConnection() *UserConnection {
+	if x != nil {
+		return x.UsersConnection
+	}
+	return nil
+}
+
+// Request message for search operation: Searches users, posts and comments, best matches first.
+type QuerySearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Types         *ListOfSearchableType  `protobuf:"bytes,2,opt,name=types,proto3" json:"types,omitempty"`
+	First         *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=first,proto3" json:"first,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySearchRequest) Reset() {
+	*x = QuerySearchRequest{}
+	mi := &file_generated_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySearchRequest) ProtoMessage() {}
+
+func (x *QuerySearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySearchRequest.ProtoReflect.Descriptor instead.
+func (*QuerySearchRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QuerySearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *QuerySearchRequest) GetTypes() *ListOfSearchableType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *QuerySearchRequest) GetFirst() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+// Response message for search operation: Searches users, posts and comments, best matches first.
+type QuerySearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Searches users, posts and comments, best matches first
+	Search        []*SearchResult `protobuf:"bytes,1,rep,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySearchResponse) Reset() {
+	*x = QuerySearchResponse{}
+	mi := &file_generated_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySearchResponse) ProtoMessage() {}
+
+func (x *QuerySearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySearchResponse.ProtoReflect.Descriptor instead.
+func (*QuerySearchResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QuerySearchResponse) GetSearch() []*SearchResult {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+// Request message for userHistory operation: Returns the changes made to a user by mutations, most recent first.
+type QueryUserHistoryRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	UserId        string                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	First         *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=first,proto3" json:"first,omitempty"`
+	After         *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryUserHistoryRequest) Reset() {
+	*x = QueryUserHistoryRequest{}
+	mi := &file_generated_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUserHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUserHistoryRequest) ProtoMessage() {}
+
+func (x *QueryUserHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUserHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryUserHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QueryUserHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QueryUserHistoryRequest) GetFirst() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *QueryUserHistoryRequest) GetAfter() *wrapperspb.StringValue {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// Response message for userHistory operation: Returns the changes made to a user by mutations, most recent first.
+type QueryUserHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Returns the changes made to a user by mutations, most recent first
+	UserHistory   *AuditRecordConnection `protobuf:"bytes,1,opt,name=user_history,json=userHistory,proto3" json:"user_history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryUserHistoryResponse) Reset() {
+	*x = QueryUserHistoryResponse{}
+	mi := &file_generated_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUserHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUserHistoryResponse) ProtoMessage() {}
+
+func (x *QueryUserHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUserHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryUserHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QueryUserHistoryResponse) GetUserHistory() *AuditRecordConnection {
+	if x != nil {
+		return x.UserHistory
+	}
+	return nil
+}
+
+// Request message for userActivityConnection operation: Returns a page of the activity items of a user, most recently created first. types limits the page to posts or comments, and since and until work like in userActivity.
+type QueryUserActivityConnectionRequest struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	UserId         string                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	First          *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=first,proto3" json:"first,omitempty"`
+	After          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	Types          *ListOfActivityType     `protobuf:"bytes,4,opt,name=types,proto3" json:"types,omitempty"`
+	Since          *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Until          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	IncludeDeleted *wrapperspb.BoolValue   `protobuf:"bytes,7,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueryUserActivityConnectionRequest) Reset() {
+	*x = QueryUserActivityConnectionRequest{}
+	mi := &file_generated_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUserActivityConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUserActivityConnectionRequest) ProtoMessage() {}
+
+func (x *QueryUserActivityConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUserActivityConnectionRequest.ProtoReflect.Descriptor instead.
+func (*QueryUserActivityConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *QueryUserActivityConnectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QueryUserActivityConnectionRequest) GetFirst() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *QueryUserActivityConnectionRequest) GetAfter() *wrapperspb.StringValue {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *QueryUserActivityConnectionRequest) GetTypes() *ListOfActivityType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *QueryUserActivityConnectionRequest) GetSince() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *QueryUserActivityConnectionRequest) GetUntil() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *QueryUserActivityConnectionRequest) GetIncludeDeleted() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return nil
+}
+
+// Response message for userActivityConnection operation: Returns a page of the activity items of a user, most recently created first. types limits the page to posts or comments, and since and until work like in userActivity.
+type QueryUserActivityConnectionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Returns a page of the activity items of a user, most recently created first. types limits the page to posts or comments, and since and until work like in userActivity
+	UserActivityConnection *ActivityConnection `protobuf:"bytes,1,opt,name=user_activity_connection,json=userActivityConnection,proto3" json:"user_activity_connection,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *QueryUserActivityConnectionResponse) Reset() {
+	*x = QueryUserActivityConnectionResponse{}
+	mi := &file_generated_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUserActivityConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUserActivityConnectionResponse) ProtoMessage() {}
+
+func (x *QueryUserActivityConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUserActivityConnectionResponse.ProtoReflect.Descriptor instead.
+func (*QueryUserActivityConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *QueryUserActivityConnectionResponse) GetUserActivityConnection() *ActivityConnection {
+	if x != nil {
+		return x.UserActivityConnection
+	}
+	return nil
+}
+
+// Request message for post operation: Returns a single post by ID. Drafts and archived posts are only returned to their author.
+type QueryPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPostRequest) Reset() {
+	*x = QueryPostRequest{}
+	mi := &file_generated_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPostRequest) ProtoMessage() {}
+
+func (x *QueryPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPostRequest.ProtoReflect.Descriptor instead.
+func (*QueryPostRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *QueryPostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for post operation: Returns a single post by ID. Drafts and archived posts are only returned to their author.
+type QueryPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Returns a single post by ID. Drafts and archived posts are only returned to their author
+	Post          *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPostResponse) Reset() {
+	*x = QueryPostResponse{}
+	mi := &file_generated_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPostResponse) ProtoMessage() {}
+
+func (x *QueryPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPostResponse.ProtoReflect.Descriptor instead.
+func (*QueryPostResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QueryPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// Request message for posts operation: Returns a page of posts, most recently created first. Drafts and archived posts are only listed for their author.
+type QueryPostsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Filter        *PostFilter             `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	First         *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=first,proto3" json:"first,omitempty"`
+	After         *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPostsRequest) Reset() {
+	*x = QueryPostsRequest{}
+	mi := &file_generated_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPostsRequest) ProtoMessage() {}
+
+func (x *QueryPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPostsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPostsRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *QueryPostsRequest) GetFilter() *PostFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *QueryPostsRequest) GetFirst() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *QueryPostsRequest) GetAfter() *wrapperspb.StringValue {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// Response message for posts operation: Returns a page of posts, most recently created first. Drafts and archived posts are only listed for their author.
+type QueryPostsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Returns a page of posts, most recently created first. Drafts and archived posts are only listed for their author
+	Posts         *PostConnection `protobuf:"bytes,1,opt,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPostsResponse) Reset() {
+	*x = QueryPostsResponse{}
+	mi := &file_generated_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPostsResponse) ProtoMessage() {}
+
+func (x *QueryPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPostsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPostsResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *QueryPostsResponse) GetPosts() *PostConnection {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+// Request message for updateUser operation: Updates a single user's information.
+type MutationUpdateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *UserInput             `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationUpdateUserRequest) Reset() {
+	*x = MutationUpdateUserRequest{}
+	mi := &file_generated_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationUpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationUpdateUserRequest) ProtoMessage() {}
+
+func (x *MutationUpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationUpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*MutationUpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MutationUpdateUserRequest) GetInput() *UserInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Response message for updateUser operation: Updates a single user's information.
+type MutationUpdateUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Updates a single user's information
+	UpdateUser    *User `protobuf:"bytes,1,opt,name=update_user,json=updateUser,proto3" json:"update_user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationUpdateUserResponse) Reset() {
+	*x = MutationUpdateUserResponse{}
+	mi := &file_generated_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationUpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationUpdateUserResponse) ProtoMessage() {}
+
+func (x *MutationUpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationUpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*MutationUpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MutationUpdateUserResponse) GetUpdateUser() *User {
+	if x != nil {
+		return x.UpdateUser
+	}
+	return nil
+}
+
+// Request message for updateUsers operation: Updates multiple users' information in a single operation. With atomic, either all users are updated or none.
+type MutationUpdateUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         []*UserInput           `protobuf:"bytes,1,rep,name=input,proto3" json:"input,omitempty"`
+	Atomic        *wrapperspb.BoolValue  `protobuf:"bytes,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationUpdateUsersRequest) Reset() {
+	*x = MutationUpdateUsersRequest{}
+	mi := &file_generated_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationUpdateUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationUpdateUsersRequest) ProtoMessage() {}
+
+func (x *MutationUpdateUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationUpdateUsersRequest.ProtoReflect.Descriptor instead.
+func (*MutationUpdateUsersRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MutationUpdateUsersRequest) GetInput() []*UserInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *MutationUpdateUsersRequest) GetAtomic() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Atomic
+	}
+	return nil
+}
+
+// Response message for updateUsers operation: Updates multiple users' information in a single operation. With atomic, either all users are updated or none.
+type MutationUpdateUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Updates multiple users' information in a single operation. With atomic, either all users are updated or none.
+	UpdateUsers   *UpdateUsersPayload `protobuf:"bytes,1,opt,name=update_users,json=updateUsers,proto3" json:"update_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationUpdateUsersResponse) Reset() {
+	*x = MutationUpdateUsersResponse{}
+	mi := &file_generated_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationUpdateUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationUpdateUsersResponse) ProtoMessage() {}
+
+func (x *MutationUpdateUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationUpdateUsersResponse.ProtoReflect.Descriptor instead.
+func (*MutationUpdateUsersResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MutationUpdateUsersResponse) GetUpdateUsers() *UpdateUsersPayload {
+	if x != nil {
+		return x.UpdateUsers
+	}
+	return nil
+}
+
+// Request message for createPost operation: Creates a new post.
+type MutationCreatePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *PostInput             `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationCreatePostRequest) Reset() {
+	*x = MutationCreatePostRequest{}
+	mi := &file_generated_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationCreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationCreatePostRequest) ProtoMessage() {}
+
+func (x *MutationCreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationCreatePostRequest.ProtoReflect.Descriptor instead.
+func (*MutationCreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MutationCreatePostRequest) GetInput() *PostInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Response message for createPost operation: Creates a new post.
+type MutationCreatePostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Creates a new post
+	CreatePost    *Post `protobuf:"bytes,1,opt,name=create_post,json=createPost,proto3" json:"create_post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationCreatePostResponse) Reset() {
+	*x = MutationCreatePostResponse{}
+	mi := &file_generated_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationCreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationCreatePostResponse) ProtoMessage() {}
+
+func (x *MutationCreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationCreatePostResponse.ProtoReflect.Descriptor instead.
+func (*MutationCreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MutationCreatePostResponse) GetCreatePost() *Post {
+	if x != nil {
+		return x.CreatePost
+	}
+	return nil
+}
+
+// Request message for createUser operation: Creates a new user with a server-generated ID.
+type MutationCreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *CreateUserInput       `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationCreateUserRequest) Reset() {
+	*x = MutationCreateUserRequest{}
+	mi := &file_generated_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationCreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationCreateUserRequest) ProtoMessage() {}
+
+func (x *MutationCreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationCreateUserRequest.ProtoReflect.Descriptor instead.
+func (*MutationCreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MutationCreateUserRequest) GetInput() *CreateUserInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Response message for createUser operation: Creates a new user with a server-generated ID.
+type MutationCreateUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Creates a new user with a server-generated ID
+	CreateUser    *User `protobuf:"bytes,1,opt,name=create_user,json=createUser,proto3" json:"create_user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationCreateUserResponse) Reset() {
+	*x = MutationCreateUserResponse{}
+	mi := &file_generated_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationCreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationCreateUserResponse) ProtoMessage() {}
+
+func (x *MutationCreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationCreateUserResponse.ProtoReflect.Descriptor instead.
+func (*MutationCreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MutationCreateUserResponse) GetCreateUser() *User {
+	if x != nil {
+		return x.CreateUser
+	}
+	return nil
+}
+
+// Request message for deleteUser operation: Marks a user as deleted and returns it. Deleted users are hidden unless includeDeleted is set.
+type MutationDeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationDeleteUserRequest) Reset() {
+	*x = MutationDeleteUserRequest{}
+	mi := &file_generated_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationDeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationDeleteUserRequest) ProtoMessage() {}
+
+func (x *MutationDeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationDeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*MutationDeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MutationDeleteUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for deleteUser operation: Marks a user as deleted and returns it. Deleted users are hidden unless includeDeleted is set.
+type MutationDeleteUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Marks a user as deleted and returns it. Deleted users are hidden unless includeDeleted is set.
+	DeleteUser    *User `protobuf:"bytes,1,opt,name=delete_user,json=deleteUser,proto3" json:"delete_user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationDeleteUserResponse) Reset() {
+	*x = MutationDeleteUserResponse{}
+	mi := &file_generated_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationDeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationDeleteUserResponse) ProtoMessage() {}
+
+func (x *MutationDeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationDeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*MutationDeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MutationDeleteUserResponse) GetDeleteUser() *User {
+	if x != nil {
+		return x.DeleteUser
+	}
+	return nil
+}
+
+// Request message for restoreUser operation: Restores a deleted user together with the posts and comments deleted with it, and returns the user.
+type MutationRestoreUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationRestoreUserRequest) Reset() {
+	*x = MutationRestoreUserRequest{}
+	mi := &file_generated_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationRestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationRestoreUserRequest) ProtoMessage() {}
+
+func (x *MutationRestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationRestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*MutationRestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MutationRestoreUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for restoreUser operation: Restores a deleted user together with the posts and comments deleted with it, and returns the user.
+type MutationRestoreUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restores a deleted user together with the posts and comments deleted with it, and returns the user
+	RestoreUser   *User `protobuf:"bytes,1,opt,name=restore_user,json=restoreUser,proto3" json:"restore_user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationRestoreUserResponse) Reset() {
+	*x = MutationRestoreUserResponse{}
+	mi := &file_generated_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationRestoreUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationRestoreUserResponse) ProtoMessage() {}
+
+func (x *MutationRestoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationRestoreUserResponse.ProtoReflect.Descriptor instead.
+func (*MutationRestoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MutationRestoreUserResponse) GetRestoreUser() *User {
+	if x != nil {
+		return x.RestoreUser
+	}
+	return nil
+}
+
+// Request message for createComment operation: Creates a comment on a post.
+type MutationCreateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *CommentInput          `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationCreateCommentRequest) Reset() {
+	*x = MutationCreateCommentRequest{}
+	mi := &file_generated_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationCreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationCreateCommentRequest) ProtoMessage() {}
+
+func (x *MutationCreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationCreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*MutationCreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MutationCreateCommentRequest) GetInput() *CommentInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Response message for createComment operation: Creates a comment on a post.
+type MutationCreateCommentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Creates a comment on a post
+	CreateComment *Comment `protobuf:"bytes,1,opt,name=create_comment,json=createComment,proto3" json:"create_comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationCreateCommentResponse) Reset() {
+	*x = MutationCreateCommentResponse{}
+	mi := &file_generated_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationCreateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationCreateCommentResponse) ProtoMessage() {}
+
+func (x *MutationCreateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationCreateCommentResponse.ProtoReflect.Descriptor instead.
+func (*MutationCreateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MutationCreateCommentResponse) GetCreateComment() *Comment {
+	if x != nil {
+		return x.CreateComment
+	}
+	return nil
+}
+
+// Request message for updateComment operation: Changes the content of a comment and returns it.
+type MutationUpdateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *UpdateCommentInput    `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationUpdateCommentRequest) Reset() {
+	*x = MutationUpdateCommentRequest{}
+	mi := &file_generated_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationUpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationUpdateCommentRequest) ProtoMessage() {}
+
+func (x *MutationUpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationUpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*MutationUpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MutationUpdateCommentRequest) GetInput() *UpdateCommentInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Response message for updateComment operation: Changes the content of a comment and returns it.
+type MutationUpdateCommentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Changes the content of a comment and returns it
+	UpdateComment *Comment `protobuf:"bytes,1,opt,name=update_comment,json=updateComment,proto3" json:"update_comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationUpdateCommentResponse) Reset() {
+	*x = MutationUpdateCommentResponse{}
+	mi := &file_generated_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationUpdateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationUpdateCommentResponse) ProtoMessage() {}
+
+func (x *MutationUpdateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationUpdateCommentResponse.ProtoReflect.Descriptor instead.
+func (*MutationUpdateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MutationUpdateCommentResponse) GetUpdateComment() *Comment {
+	if x != nil {
+		return x.UpdateComment
+	}
+	return nil
+}
+
+// Request message for deleteComment operation: Marks a comment as deleted and returns it.
+type MutationDeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationDeleteCommentRequest) Reset() {
+	*x = MutationDeleteCommentRequest{}
+	mi := &file_generated_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationDeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationDeleteCommentRequest) ProtoMessage() {}
+
+func (x *MutationDeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationDeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*MutationDeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MutationDeleteCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for deleteComment operation: Marks a comment as deleted and returns it.
+type MutationDeleteCommentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Marks a comment as deleted and returns it
+	DeleteComment *Comment `protobuf:"bytes,1,opt,name=delete_comment,json=deleteComment,proto3" json:"delete_comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationDeleteCommentResponse) Reset() {
+	*x = MutationDeleteCommentResponse{}
+	mi := &file_generated_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationDeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationDeleteCommentResponse) ProtoMessage() {}
+
+func (x *MutationDeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationDeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*MutationDeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MutationDeleteCommentResponse) GetDeleteComment() *Comment {
+	if x != nil {
+		return x.DeleteComment
+	}
+	return nil
+}
+
+// Request message for updatePost operation: Changes the title or status of a post and returns it.
+type MutationUpdatePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *UpdatePostInput       `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationUpdatePostRequest) Reset() {
+	*x = MutationUpdatePostRequest{}
+	mi := &file_generated_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationUpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationUpdatePostRequest) ProtoMessage() {}
+
+func (x *MutationUpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationUpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*MutationUpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MutationUpdatePostRequest) GetInput() *UpdatePostInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Response message for updatePost operation: Changes the title or status of a post and returns it.
+type MutationUpdatePostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Changes the title or status of a post and returns it
+	UpdatePost    *Post `protobuf:"bytes,1,opt,name=update_post,json=updatePost,proto3" json:"update_post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationUpdatePostResponse) Reset() {
+	*x = MutationUpdatePostResponse{}
+	mi := &file_generated_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationUpdatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationUpdatePostResponse) ProtoMessage() {}
+
+func (x *MutationUpdatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationUpdatePostResponse.ProtoReflect.Descriptor instead.
+func (*MutationUpdatePostResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MutationUpdatePostResponse) GetUpdatePost() *Post {
+	if x != nil {
+		return x.UpdatePost
+	}
+	return nil
+}
+
+// Request message for deletePost operation: Marks a post as deleted and returns it.
+type MutationDeletePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationDeletePostRequest) Reset() {
+	*x = MutationDeletePostRequest{}
+	mi := &file_generated_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationDeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationDeletePostRequest) ProtoMessage() {}
+
+func (x *MutationDeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationDeletePostRequest.ProtoReflect.Descriptor instead.
+func (*MutationDeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MutationDeletePostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for deletePost operation: Marks a post as deleted and returns it.
+type MutationDeletePostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Marks a post as deleted and returns it
+	DeletePost    *Post `protobuf:"bytes,1,opt,name=delete_post,json=deletePost,proto3" json:"delete_post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationDeletePostResponse) Reset() {
+	*x = MutationDeletePostResponse{}
+	mi := &file_generated_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationDeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationDeletePostResponse) ProtoMessage() {}
+
+func (x *MutationDeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationDeletePostResponse.ProtoReflect.Descriptor instead.
+func (*MutationDeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MutationDeletePostResponse) GetDeletePost() *Post {
+	if x != nil {
+		return x.DeletePost
+	}
+	return nil
+}
+
+type User struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier for the user
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The user's name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The user's email address
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// The user's role in the system
+	Role UserRole `protobuf:"varint,4,opt,name=role,proto3,enum=service.UserRole" json:"role,omitempty"`
+	// List of permissions granted to the user (always present, may be empty)
+	Permissions []string `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Optional list of tags associated with the user for categorization
+	Tags *ListOfString `protobuf:"bytes,6,opt,name=tags,proto3" json:"tags,omitempty"`
+	// Nested list: Skills organized by categories (e.g. [["JavaScript", "TypeScript"], ["React", "Vue"]])
+	SkillCategories *ListOfListOfString `protobuf:"bytes,7,opt,name=skill_categories,json=skillCategories,proto3" json:"skill_categories,omitempty"`
+	// Union type: Recent user activities
+	RecentActivity []*ActivityItem `protobuf:"bytes,8,rep,name=recent_activity,json=recentActivity,proto3" json:"recent_activity,omitempty"`
+	// Nullable complex type: User profile information
+	Profile *Profile `protobuf:"bytes,9,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Nullable string: User biography
+	Bio *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=bio,proto3" json:"bio,omitempty"`
+	// Nullable integer: User age
+	Age *wrapperspb.Int32Value `protobuf:"bytes,11,opt,name=age,proto3" json:"age,omitempty"`
+	// Incremented by every change of the user, starting at 1
+	Version int32 `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	// Time of the last change of the user, in RFC 3339 format
+	UpdatedAt string `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Time the user was deleted, in RFC 3339 format. Null unless the user is deleted.
+	DeletedAt     *wrapperspb.StringValue `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_generated_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *User) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *User) GetTags() *ListOfString {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *User) GetSkillCategories() *ListOfListOfString {
+	if x != nil {
+		return x.SkillCategories
+	}
+	return nil
+}
+
+func (x *User) GetRecentActivity() []*ActivityItem {
+	if x != nil {
+		return x.RecentActivity
+	}
+	return nil
+}
+
+func (x *User) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *User) GetBio() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Bio
+	}
+	return nil
+}
+
+func (x *User) GetAge() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *User) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *User) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *User) GetDeletedAt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+// A page of users
+type UserConnection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The users on this page, each with its cursor
+	Edges []*UserEdge `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Information to fetch the neighbouring pages
+	PageInfo *PageInfo `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	// Total number of users across all pages
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserConnection) Reset() {
+	*x = UserConnection{}
+	mi := &file_generated_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserConnection) ProtoMessage() {}
+
+func (x *UserConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserConnection.ProtoReflect.Descriptor instead.
+func (*UserConnection) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UserConnection) GetEdges() []*UserEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *UserConnection) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+func (x *UserConnection) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ExternalUser struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                  `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                  `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Phone         *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Website       *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
+	Company       *Company                `protobuf:"bytes,7,opt,name=company,proto3" json:"company,omitempty"`
+	Address       *Address                `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalUser) Reset() {
+	*x = ExternalUser{}
+	mi := &file_generated_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalUser) ProtoMessage() {}
+
+func (x *ExternalUser) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalUser.ProtoReflect.Descriptor instead.
+func (*ExternalUser) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ExternalUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExternalUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExternalUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ExternalUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ExternalUser) GetPhone() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
+}
+
+func (x *ExternalUser) GetWebsite() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Website
+	}
+	return nil
+}
+
+func (x *ExternalUser) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+func (x *ExternalUser) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// Union type representing different types of user activities
+type ActivityItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*ActivityItem_Post
+	//	*ActivityItem_Comment
+	Value         isActivityItem_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityItem) Reset() {
+	*x = ActivityItem{}
+	mi := &file_generated_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityItem) ProtoMessage() {}
+
+func (x *ActivityItem) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityItem.ProtoReflect.Descriptor instead.
+func (*ActivityItem) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ActivityItem) GetValue() isActivityItem_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ActivityItem) GetPost() *Post {
+	if x != nil {
+		if x, ok := x.Value.(*ActivityItem_Post); ok {
+			return x.Post
+		}
+	}
+	return nil
+}
+
+func (x *ActivityItem) GetComment() *Comment {
+	if x != nil {
+		if x, ok := x.Value.(*ActivityItem_Comment); ok {
+			return x.Comment
+		}
+	}
+	return nil
+}
+
+type isActivityItem_Value interface {
+	isActivityItem_Value()
+}
+
+type ActivityItem_Post struct {
+	// A simple post by a user
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3,oneof"`
+}
+
+type ActivityItem_Comment struct {
+	// A comment by a user
+	Comment *Comment `protobuf:"bytes,2,opt,name=comment,proto3,oneof"`
+}
+
+func (*ActivityItem_Post) isActivityItem_Value() {}
+
+func (*ActivityItem_Comment) isActivityItem_Value() {}
+
+// Input type for user updates. Fields that are omitted or null keep their
+// current value; fields that are given, even as an empty string or list,
+// replace it. To set a nullable field to null, list it in unset.
+type UserInput struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Id              string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email           *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role            UserRole                `protobuf:"varint,4,opt,name=role,proto3,enum=service.UserRole" json:"role,omitempty"`
+	Permissions     *ListOfString           `protobuf:"bytes,5,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	Tags            *ListOfString           `protobuf:"bytes,6,opt,name=tags,proto3" json:"tags,omitempty"`
+	SkillCategories *ListOfListOfString     `protobuf:"bytes,7,opt,name=skill_categories,json=skillCategories,proto3" json:"skill_categories,omitempty"`
+	Bio             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=bio,proto3" json:"bio,omitempty"`
+	Age             *wrapperspb.Int32Value  `protobuf:"bytes,9,opt,name=age,proto3" json:"age,omitempty"`
+	Profile         *ProfileInput           `protobuf:"bytes,10,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Nullable fields to set to null. A field can't be given a value and be unset at the same time.
+	Unset *ListOfNullableUserField `protobuf:"bytes,11,opt,name=unset,proto3" json:"unset,omitempty"`
+	// The version the update is based on. If given, the update fails with a conflict when the user has been changed since.
+	ExpectedVersion *wrapperspb.Int32Value `protobuf:"bytes,12,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserInput) Reset() {
+	*x = UserInput{}
+	mi := &file_generated_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInput) ProtoMessage() {}
+
+func (x *UserInput) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInput.ProtoReflect.Descriptor instead.
+func (*UserInput) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UserInput) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserInput) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UserInput) GetEmail() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *UserInput) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *UserInput) GetPermissions() *ListOfString {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *UserInput) GetTags() *ListOfString {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UserInput) GetSkillCategories() *ListOfListOfString {
+	if x != nil {
+		return x.SkillCategories
+	}
+	return nil
+}
+
+func (x *UserInput) GetBio() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Bio
+	}
+	return nil
+}
+
+func (x *UserInput) GetAge() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *UserInput) GetProfile() *ProfileInput {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UserInput) GetUnset() *ListOfNullableUserField {
+	if x != nil {
+		return x.Unset
+	}
+	return nil
+}
+
+func (x *UserInput) GetExpectedVersion() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
+// Sort order for user lists. Users that compare equal are ordered by ID.
+type UserOrder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The field to sort by
+	Field UserOrderField `protobuf:"varint,1,opt,name=field,proto3,enum=service.UserOrderField" json:"field,omitempty"`
+	// The sort direction
+	Direction     OrderDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=service.OrderDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserOrder) Reset() {
+	*x = UserOrder{}
+	mi := &file_generated_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOrder) ProtoMessage() {}
+
+func (x *UserOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOrder.ProtoReflect.Descriptor instead.
+func (*UserOrder) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UserOrder) GetField() UserOrderField {
+	if x != nil {
+		return x.Field
+	}
+	return UserOrderField_USER_ORDER_FIELD_UNSPECIFIED
+}
+
+func (x *UserOrder) GetDirection() OrderDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return OrderDirection_ORDER_DIRECTION_UNSPECIFIED
+}
+
+// Filter for user lists. All conditions that are set must match.
+type UserFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user's role is one of the given roles
+	RoleIn *ListOfUserRole `protobuf:"bytes,1,opt,name=role_in,json=roleIn,proto3" json:"role_in,omitempty"`
+	// The user has at least one of the given tags
+	TagsContainsAny *ListOfString `protobuf:"bytes,2,opt,name=tags_contains_any,json=tagsContainsAny,proto3" json:"tags_contains_any,omitempty"`
+	// The user has all of the given permissions
+	PermissionsContainsAll *ListOfString `protobuf:"bytes,3,opt,name=permissions_contains_all,json=permissionsContainsAll,proto3" json:"permissions_contains_all,omitempty"`
+	// The user's age is within the range. Users without an age never match.
+	Age *IntRange `protobuf:"bytes,4,opt,name=age,proto3" json:"age,omitempty"`
+	// The user lists the skill in any skill category (case-insensitive)
+	Skill *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=skill,proto3" json:"skill,omitempty"`
+	// All of the given filters match
+	And *ListOfUserFilter `protobuf:"bytes,6,opt,name=and,proto3" json:"and,omitempty"`
+	// At least one of the given filters matches
+	Or *ListOfUserFilter `protobuf:"bytes,7,opt,name=or,proto3" json:"or,omitempty"`
+	// The given filter does not match
+	Not           *UserFilter `protobuf:"bytes,8,opt,name=not,proto3" json:"not,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFilter) Reset() {
+	*x = UserFilter{}
+	mi := &file_generated_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter) ProtoMessage() {}
+
+func (x *UserFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter.ProtoReflect.Descriptor instead.
+func (*UserFilter) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UserFilter) GetRoleIn() *ListOfUserRole {
+	if x != nil {
+		return x.RoleIn
+	}
+	return nil
+}
+
+func (x *UserFilter) GetTagsContainsAny() *ListOfString {
+	if x != nil {
+		return x.TagsContainsAny
+	}
+	return nil
+}
+
+func (x *UserFilter) GetPermissionsContainsAll() *ListOfString {
+	if x != nil {
+		return x.PermissionsContainsAll
+	}
+	return nil
+}
+
+func (x *UserFilter) GetAge() *IntRange {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *UserFilter) GetSkill() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+func (x *UserFilter) GetAnd() *ListOfUserFilter {
+	if x != nil {
+		return x.And
+	}
+	return nil
+}
+
+func (x *UserFilter) GetOr() *ListOfUserFilter {
+	if x != nil {
+		return x.Or
+	}
+	return nil
+}
+
+func (x *UserFilter) GetNot() *UserFilter {
+	if x != nil {
+		return x.Not
+	}
+	return nil
+}
+
+// Range of integers. Bounds that are not set are open.
+type IntRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Greater than
+	Gt *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=gt,proto3" json:"gt,omitempty"`
+	// Greater than or equal to
+	Gte *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=gte,proto3" json:"gte,omitempty"`
+	// Less than
+	Lt *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=lt,proto3" json:"lt,omitempty"`
+	// Less than or equal to
+	Lte           *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=lte,proto3" json:"lte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntRange) Reset() {
+	*x = IntRange{}
+	mi := &file_generated_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntRange) ProtoMessage() {}
+
+func (x *IntRange) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntRange.ProtoReflect.Descriptor instead.
+func (*IntRange) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *IntRange) GetGt() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *IntRange) GetGte() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *IntRange) GetLt() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *IntRange) GetLte() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+// Input type for creating posts
+type PostInput struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Title    string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	AuthorId string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Publication state of the new post, PUBLISHED if not given
+	Status        PostStatus `protobuf:"varint,3,opt,name=status,proto3,enum=service.PostStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostInput) Reset() {
+	*x = PostInput{}
+	mi := &file_generated_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostInput) ProtoMessage() {}
+
+func (x *PostInput) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostInput.ProtoReflect.Descriptor instead.
+func (*PostInput) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PostInput) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PostInput) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PostInput) GetStatus() PostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostStatus_POST_STATUS_UNSPECIFIED
+}
+
+// A simple post by a user
+type Post struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier for the post
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Post title
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Publication state of the post
+	Status PostStatus `protobuf:"varint,8,opt,name=status,proto3,enum=service.PostStatus" json:"status,omitempty"`
+	// Post author ID
+	AuthorId string `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Comments on the post, oldest first
+	Comments []*Comment `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
+	// Time the post was created, in RFC 3339 format
+	CreatedAt string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Time of the last change of the post, in RFC 3339 format
+	UpdatedAt string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Time the post was deleted, in RFC 3339 format. Null unless the post is deleted.
+	DeletedAt     *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_generated_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Post) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Post) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Post) GetStatus() PostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostStatus_POST_STATUS_UNSPECIFIED
+}
+
+func (x *Post) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Post) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *Post) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Post) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Post) GetDeletedAt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+// Interface for entities with unique identifiers
+type Node struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Instance:
+	//
+	//	*Node_User
+	//	*Node_Post
+	//	*Node_Comment
+	Instance      isNode_Instance `protobuf_oneof:"instance"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_generated_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Node) GetInstance() isNode_Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *Node) GetUser() *User {
+	if x != nil {
+		if x, ok := x.Instance.(*Node_User); ok {
+			return x.User
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetPost() *Post {
+	if x != nil {
+		if x, ok := x.Instance.(*Node_Post); ok {
+			return x.Post
+		}
+	}
+	return nil
+}
+
+func (x *Node) GetComment() *Comment {
+	if x != nil {
+		if x, ok := x.Instance.(*Node_Comment); ok {
+			return x.Comment
+		}
+	}
+	return nil
+}
+
+type isNode_Instance interface {
+	isNode_Instance()
+}
+
+type Node_User struct {
+	User *User `protobuf:"bytes,1,opt,name=user,proto3,oneof"`
+}
+
+type Node_Post struct {
+	// A simple post by a user
+	Post *Post `protobuf:"bytes,2,opt,name=post,proto3,oneof"`
+}
+
+type Node_Comment struct {
+	// A comment by a user
+	Comment *Comment `protobuf:"bytes,3,opt,name=comment,proto3,oneof"`
+}
+
+func (*Node_User) isNode_Instance() {}
+
+func (*Node_Post) isNode_Instance() {}
+
+func (*Node_Comment) isNode_Instance() {}
+
+// User profile information (nullable complex type)
+type Profile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User's display name preference
+	DisplayName *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// User's timezone preference
+	Timezone *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Theme preference
+	Theme         Theme `protobuf:"varint,3,opt,name=theme,proto3,enum=service.Theme" json:"theme,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_generated_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Profile) GetDisplayName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DisplayName
+	}
+	return nil
+}
+
+func (x *Profile) GetTimezone() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Timezone
+	}
+	return nil
+}
+
+func (x *Profile) GetTheme() Theme {
+	if x != nil {
+		return x.Theme
+	}
+	return Theme_THEME_UNSPECIFIED
+}
+
+// A comment by a user
+type Comment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier for the comment
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Comment content
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Comment author ID
+	AuthorId string `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// ID of the post the comment belongs to
+	PostId string `protobuf:"bytes,7,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Time the comment was created, in RFC 3339 format
+	CreatedAt string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Time of the last change of the comment, in RFC 3339 format
+	UpdatedAt string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Time the comment was deleted, in RFC 3339 format. Null unless the comment is deleted.
+	DeletedAt     *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_generated_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Comment) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Comment) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Comment) GetDeletedAt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+// Union type representing the results of a search
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*SearchResult_User
+	//	*SearchResult_Post
+	//	*SearchResult_Comment
+	Value         isSearchResult_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_generated_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SearchResult) GetValue() isSearchResult_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SearchResult) GetUser() *User {
+	if x != nil {
+		if x, ok := x.Value.(*SearchResult_User); ok {
+			return x.User
+		}
+	}
+	return nil
+}
+
+func (x *SearchResult) GetPost() *Post {
+	if x != nil {
+		if x, ok := x.Value.(*SearchResult_Post); ok {
+			return x.Post
+		}
+	}
+	return nil
+}
+
+func (x *SearchResult) GetComment() *Comment {
+	if x != nil {
+		if x, ok := x.Value.(*SearchResult_Comment); ok {
+			return x.Comment
+		}
+	}
+	return nil
+}
+
+type isSearchResult_Value interface {
+	isSearchResult_Value()
+}
+
+type SearchResult_User struct {
+	User *User `protobuf:"bytes,1,opt,name=user,proto3,oneof"`
+}
+
+type SearchResult_Post struct {
+	// A simple post by a user
+	Post *Post `protobuf:"bytes,2,opt,name=post,proto3,oneof"`
+}
+
+type SearchResult_Comment struct {
+	// A comment by a user
+	Comment *Comment `protobuf:"bytes,3,opt,name=comment,proto3,oneof"`
+}
+
+func (*SearchResult_User) isSearchResult_Value() {}
+
+func (*SearchResult_Post) isSearchResult_Value() {}
+
+func (*SearchResult_Comment) isSearchResult_Value() {}
+
+type Company struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CatchPhrase   *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=catch_phrase,json=catchPhrase,proto3" json:"catch_phrase,omitempty"`
+	Bs            *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=bs,proto3" json:"bs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Company) Reset() {
+	*x = Company{}
+	mi := &file_generated_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Company) ProtoMessage() {}
+
+func (x *Company) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Company.ProtoReflect.Descriptor instead.
+func (*Company) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Company) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Company) GetCatchPhrase() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CatchPhrase
+	}
+	return nil
+}
+
+func (x *Company) GetBs() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Bs
+	}
+	return nil
+}
+
+type Address struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Street        *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	Suite         *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=suite,proto3" json:"suite,omitempty"`
+	City          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Zipcode       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=zipcode,proto3" json:"zipcode,omitempty"`
+	Geo           *Geo                    `protobuf:"bytes,5,opt,name=geo,proto3" json:"geo,omitempty"`
+	Test          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=test,proto3" json:"test,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_generated_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Address) GetStreet() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Street
+	}
+	return nil
+}
+
+func (x *Address) GetSuite() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Suite
+	}
+	return nil
+}
+
+func (x *Address) GetCity() *wrapperspb.StringValue {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+func (x *Address) GetZipcode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Zipcode
+	}
+	return nil
+}
+
+func (x *Address) GetGeo() *Geo {
+	if x != nil {
+		return x.Geo
+	}
+	return nil
+}
+
+func (x *Address) GetTest() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Test
+	}
+	return nil
+}
+
+type Geo struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Lat           *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Geo) Reset() {
+	*x = Geo{}
+	mi := &file_generated_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Geo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Geo) ProtoMessage() {}
+
+func (x *Geo) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Geo.ProtoReflect.Descriptor instead.
+func (*Geo) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Geo) GetLat() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lat
+	}
+	return nil
+}
+
+func (x *Geo) GetLng() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Lng
+	}
+	return nil
+}
+
+// Input type for creating users
+type CreateUserInput struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Name            string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email           string                  `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role            UserRole                `protobuf:"varint,3,opt,name=role,proto3,enum=service.UserRole" json:"role,omitempty"`
+	Permissions     *ListOfString           `protobuf:"bytes,4,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	Tags            *ListOfString           `protobuf:"bytes,5,opt,name=tags,proto3" json:"tags,omitempty"`
+	SkillCategories *ListOfListOfString     `protobuf:"bytes,6,opt,name=skill_categories,json=skillCategories,proto3" json:"skill_categories,omitempty"`
+	Bio             *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=bio,proto3" json:"bio,omitempty"`
+	Age             *wrapperspb.Int32Value  `protobuf:"bytes,8,opt,name=age,proto3" json:"age,omitempty"`
+	Profile         *ProfileInput           `protobuf:"bytes,9,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateUserInput) Reset() {
+	*x = CreateUserInput{}
+	mi := &file_generated_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserInput) ProtoMessage() {}
+
+func (x *CreateUserInput) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserInput.ProtoReflect.Descriptor instead.
+func (*CreateUserInput) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateUserInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateUserInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserInput) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *CreateUserInput) GetPermissions() *ListOfString {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *CreateUserInput) GetTags() *ListOfString {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateUserInput) GetSkillCategories() *ListOfListOfString {
+	if x != nil {
+		return x.SkillCategories
+	}
+	return nil
+}
+
+func (x *CreateUserInput) GetBio() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Bio
+	}
+	return nil
+}
+
+func (x *CreateUserInput) GetAge() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *CreateUserInput) GetProfile() *ProfileInput {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Input type for user profile updates
+type ProfileInput struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	DisplayName *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Timezone    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Theme       Theme                   `protobuf:"varint,3,opt,name=theme,proto3,enum=service.Theme" json:"theme,omitempty"`
+	// Profile fields to set to null when updating a user. A field can't be given a value and be unset at the same time.
+	Unset         *ListOfNullableProfileField `protobuf:"bytes,4,opt,name=unset,proto3" json:"unset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileInput) Reset() {
+	*x = ProfileInput{}
+	mi := &file_generated_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileInput) ProtoMessage() {}
+
+func (x *ProfileInput) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileInput.ProtoReflect.Descriptor instead.
+func (*ProfileInput) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ProfileInput) GetDisplayName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DisplayName
+	}
+	return nil
+}
+
+func (x *ProfileInput) GetTimezone() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Timezone
+	}
+	return nil
+}
+
+func (x *ProfileInput) GetTheme() Theme {
+	if x != nil {
+		return x.Theme
+	}
+	return Theme_THEME_UNSPECIFIED
+}
+
+func (x *ProfileInput) GetUnset() *ListOfNullableProfileField {
+	if x != nil {
+		return x.Unset
+	}
+	return nil
+}
+
+// A user on a page, together with the cursor pointing at it
+type UserEdge struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Returns a single external user by ID
-	ExternalUser  *ExternalUser `protobuf:"bytes,1,opt,name=external_user,json=externalUser,proto3" json:"external_user,omitempty"`
+	// The user
+	Node *User `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Opaque cursor to pass as after or before
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryExternalUserResponse) Reset() {
-	*x = QueryExternalUserResponse{}
-	mi := &file_generated_service_proto_msgTypes[12]
+func (x *UserEdge) Reset() {
+	*x = UserEdge{}
+	mi := &file_generated_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryExternalUserResponse) String() string {
+func (x *UserEdge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryExternalUserResponse) ProtoMessage() {}
+func (*UserEdge) ProtoMessage() {}
 
-func (x *QueryExternalUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[12]
+func (x *UserEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,42 +5412,55 @@ func (x *QueryExternalUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryExternalUserResponse.ProtoReflect.Descriptor instead.
-func (*QueryExternalUserResponse) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UserEdge.ProtoReflect.Descriptor instead.
+func (*UserEdge) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *QueryExternalUserResponse) GetExternalUser() *ExternalUser {
+func (x *UserEdge) GetNode() *User {
 	if x != nil {
-		return x.ExternalUser
+		return x.Node
 	}
 	return nil
 }
 
-// Request message for userActivity operation: Returns recent activity items for a user.
-type QueryUserActivityRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Limit         *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+func (x *UserEdge) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Pagination information of a connection
+type PageInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether more items exist after endCursor
+	HasNextPage bool `protobuf:"varint,1,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	// Whether more items exist before startCursor
+	HasPreviousPage bool `protobuf:"varint,2,opt,name=has_previous_page,json=hasPreviousPage,proto3" json:"has_previous_page,omitempty"`
+	// Cursor of the first item on the page
+	StartCursor *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=start_cursor,json=startCursor,proto3" json:"start_cursor,omitempty"`
+	// Cursor of the last item on the page
+	EndCursor     *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=end_cursor,json=endCursor,proto3" json:"end_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryUserActivityRequest) Reset() {
-	*x = QueryUserActivityRequest{}
-	mi := &file_generated_service_proto_msgTypes[13]
+func (x *PageInfo) Reset() {
+	*x = PageInfo{}
+	mi := &file_generated_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryUserActivityRequest) String() string {
+func (x *PageInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryUserActivityRequest) ProtoMessage() {}
+func (*PageInfo) ProtoMessage() {}
 
-func (x *QueryUserActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[13]
+func (x *PageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,49 +5471,63 @@ func (x *QueryUserActivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryUserActivityRequest.ProtoReflect.Descriptor instead.
-func (*QueryUserActivityRequest) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use PageInfo.ProtoReflect.Descriptor instead.
+func (*PageInfo) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *QueryUserActivityRequest) GetUserId() string {
+func (x *PageInfo) GetHasNextPage() bool {
 	if x != nil {
-		return x.UserId
+		return x.HasNextPage
 	}
-	return ""
+	return false
 }
 
-func (x *QueryUserActivityRequest) GetLimit() *wrapperspb.Int32Value {
+func (x *PageInfo) GetHasPreviousPage() bool {
 	if x != nil {
-		return x.Limit
+		return x.HasPreviousPage
+	}
+	return false
+}
+
+func (x *PageInfo) GetStartCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.StartCursor
 	}
 	return nil
 }
 
-// Response message for userActivity operation: Returns recent activity items for a user.
-type QueryUserActivityResponse struct {
+func (x *PageInfo) GetEndCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.EndCursor
+	}
+	return nil
+}
+
+// Result of a batch user update
+type UpdateUsersPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Returns recent activity items for a user
-	UserActivity  []*ActivityItem `protobuf:"bytes,1,rep,name=user_activity,json=userActivity,proto3" json:"user_activity,omitempty"`
+	// One result per input, in the order of the inputs
+	Results       []*UpdateUserResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryUserActivityResponse) Reset() {
-	*x = QueryUserActivityResponse{}
-	mi := &file_generated_service_proto_msgTypes[14]
+func (x *UpdateUsersPayload) Reset() {
+	*x = UpdateUsersPayload{}
+	mi := &file_generated_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryUserActivityResponse) String() string {
+func (x *UpdateUsersPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryUserActivityResponse) ProtoMessage() {}
+func (*UpdateUsersPayload) ProtoMessage() {}
 
-func (x *QueryUserActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[14]
+func (x *UpdateUsersPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,41 +5538,47 @@ func (x *QueryUserActivityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryUserActivityResponse.ProtoReflect.Descriptor instead.
-func (*QueryUserActivityResponse) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UpdateUsersPayload.ProtoReflect.Descriptor instead.
+func (*UpdateUsersPayload) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *QueryUserActivityResponse) GetUserActivity() []*ActivityItem {
+func (x *UpdateUsersPayload) GetResults() []*UpdateUserResult {
 	if x != nil {
-		return x.UserActivity
+		return x.Results
 	}
 	return nil
 }
 
-// Request message for updateUser operation: Updates a single user's information.
-type MutationUpdateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         *UserInput             `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+// Outcome of a single input of a batch user update
+type UpdateUserResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*UpdateUserResult_UpdatedUser
+	//	*UpdateUserResult_UserNotFound
+	//	*UpdateUserResult_ValidationFailed
+	//	*UpdateUserResult_VersionConflict
+	Value         isUpdateUserResult_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MutationUpdateUserRequest) Reset() {
-	*x = MutationUpdateUserRequest{}
-	mi := &file_generated_service_proto_msgTypes[15]
+func (x *UpdateUserResult) Reset() {
+	*x = UpdateUserResult{}
+	mi := &file_generated_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MutationUpdateUserRequest) String() string {
+func (x *UpdateUserResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MutationUpdateUserRequest) ProtoMessage() {}
+func (*UpdateUserResult) ProtoMessage() {}
 
-func (x *MutationUpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[15]
+func (x *UpdateUserResult) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,87 +5589,110 @@ func (x *MutationUpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MutationUpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*MutationUpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UpdateUserResult.ProtoReflect.Descriptor instead.
+func (*UpdateUserResult) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *MutationUpdateUserRequest) GetInput() *UserInput {
+func (x *UpdateUserResult) GetValue() isUpdateUserResult_Value {
 	if x != nil {
-		return x.Input
+		return x.Value
 	}
 	return nil
 }
 
-// Response message for updateUser operation: Updates a single user's information.
-type MutationUpdateUserResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Updates a single user's information
-	UpdateUser    *User `protobuf:"bytes,1,opt,name=update_user,json=updateUser,proto3" json:"update_user,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateUserResult) GetUpdatedUser() *UpdatedUser {
+	if x != nil {
+		if x, ok := x.Value.(*UpdateUserResult_UpdatedUser); ok {
+			return x.UpdatedUser
+		}
+	}
+	return nil
 }
 
-func (x *MutationUpdateUserResponse) Reset() {
-	*x = MutationUpdateUserResponse{}
-	mi := &file_generated_service_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateUserResult) GetUserNotFound() *UserNotFound {
+	if x != nil {
+		if x, ok := x.Value.(*UpdateUserResult_UserNotFound); ok {
+			return x.UserNotFound
+		}
+	}
+	return nil
 }
 
-func (x *MutationUpdateUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateUserResult) GetValidationFailed() *ValidationFailed {
+	if x != nil {
+		if x, ok := x.Value.(*UpdateUserResult_ValidationFailed); ok {
+			return x.ValidationFailed
+		}
+	}
+	return nil
 }
 
-func (*MutationUpdateUserResponse) ProtoMessage() {}
-
-func (x *MutationUpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[16]
+func (x *UpdateUserResult) GetVersionConflict() *VersionConflict {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Value.(*UpdateUserResult_VersionConflict); ok {
+			return x.VersionConflict
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use MutationUpdateUserResponse.ProtoReflect.Descriptor instead.
-func (*MutationUpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{16}
+type isUpdateUserResult_Value interface {
+	isUpdateUserResult_Value()
 }
 
-func (x *MutationUpdateUserResponse) GetUpdateUser() *User {
-	if x != nil {
-		return x.UpdateUser
-	}
-	return nil
+type UpdateUserResult_UpdatedUser struct {
+	// The input was applied
+	UpdatedUser *UpdatedUser `protobuf:"bytes,1,opt,name=updated_user,json=updatedUser,proto3,oneof"`
 }
 
-// Request message for updateUsers operation: Updates multiple users' information in a single operation.
-type MutationUpdateUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         []*UserInput           `protobuf:"bytes,1,rep,name=input,proto3" json:"input,omitempty"`
+type UpdateUserResult_UserNotFound struct {
+	// No user with the ID of the input exists
+	UserNotFound *UserNotFound `protobuf:"bytes,2,opt,name=user_not_found,json=userNotFound,proto3,oneof"`
+}
+
+type UpdateUserResult_ValidationFailed struct {
+	// The input was not applied
+	ValidationFailed *ValidationFailed `protobuf:"bytes,3,opt,name=validation_failed,json=validationFailed,proto3,oneof"`
+}
+
+type UpdateUserResult_VersionConflict struct {
+	// The user has been changed since the version the input expected
+	VersionConflict *VersionConflict `protobuf:"bytes,4,opt,name=version_conflict,json=versionConflict,proto3,oneof"`
+}
+
+func (*UpdateUserResult_UpdatedUser) isUpdateUserResult_Value() {}
+
+func (*UpdateUserResult_UserNotFound) isUpdateUserResult_Value() {}
+
+func (*UpdateUserResult_ValidationFailed) isUpdateUserResult_Value() {}
+
+func (*UpdateUserResult_VersionConflict) isUpdateUserResult_Value() {}
+
+// The input was applied
+type UpdatedUser struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user after the input was applied
+	User          *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MutationUpdateUsersRequest) Reset() {
-	*x = MutationUpdateUsersRequest{}
-	mi := &file_generated_service_proto_msgTypes[17]
+func (x *UpdatedUser) Reset() {
+	*x = UpdatedUser{}
+	mi := &file_generated_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MutationUpdateUsersRequest) String() string {
+func (x *UpdatedUser) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MutationUpdateUsersRequest) ProtoMessage() {}
+func (*UpdatedUser) ProtoMessage() {}
 
-func (x *MutationUpdateUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[17]
+func (x *UpdatedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,42 +5703,44 @@ func (x *MutationUpdateUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MutationUpdateUsersRequest.ProtoReflect.Descriptor instead.
-func (*MutationUpdateUsersRequest) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UpdatedUser.ProtoReflect.Descriptor instead.
+func (*UpdatedUser) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *MutationUpdateUsersRequest) GetInput() []*UserInput {
+func (x *UpdatedUser) GetUser() *User {
 	if x != nil {
-		return x.Input
+		return x.User
 	}
 	return nil
 }
 
-// Response message for updateUsers operation: Updates multiple users' information in a single operation.
-type MutationUpdateUsersResponse struct {
+// No user with the ID of the input exists
+type UserNotFound struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Updates multiple users' information in a single operation
-	UpdateUsers   []*User `protobuf:"bytes,1,rep,name=update_users,json=updateUsers,proto3" json:"update_users,omitempty"`
+	// The ID given in the input
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Human-readable description of the failure
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MutationUpdateUsersResponse) Reset() {
-	*x = MutationUpdateUsersResponse{}
-	mi := &file_generated_service_proto_msgTypes[18]
+func (x *UserNotFound) Reset() {
+	*x = UserNotFound{}
+	mi := &file_generated_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MutationUpdateUsersResponse) String() string {
+func (x *UserNotFound) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MutationUpdateUsersResponse) ProtoMessage() {}
+func (*UserNotFound) ProtoMessage() {}
 
-func (x *MutationUpdateUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[18]
+func (x *UserNotFound) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,41 +5751,53 @@ func (x *MutationUpdateUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MutationUpdateUsersResponse.ProtoReflect.Descriptor instead.
-func (*MutationUpdateUsersResponse) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use UserNotFound.ProtoReflect.Descriptor instead.
+func (*UserNotFound) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *MutationUpdateUsersResponse) GetUpdateUsers() []*User {
+func (x *UserNotFound) GetId() string {
 	if x != nil {
-		return x.UpdateUsers
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// Request message for createPost operation: Creates a new post.
-type MutationCreatePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         *PostInput             `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+func (x *UserNotFound) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// The input was not applied
+type ValidationFailed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID given in the input
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Path of the invalid field within the input, e.g. profile.timezone. Null if the input is valid but was part of a rejected atomic batch.
+	Field *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// Human-readable description of the failure
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MutationCreatePostRequest) Reset() {
-	*x = MutationCreatePostRequest{}
-	mi := &file_generated_service_proto_msgTypes[19]
+func (x *ValidationFailed) Reset() {
+	*x = ValidationFailed{}
+	mi := &file_generated_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MutationCreatePostRequest) String() string {
+func (x *ValidationFailed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MutationCreatePostRequest) ProtoMessage() {}
+func (*ValidationFailed) ProtoMessage() {}
 
-func (x *MutationCreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[19]
+func (x *ValidationFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,42 +5808,62 @@ func (x *MutationCreatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MutationCreatePostRequest.ProtoReflect.Descriptor instead.
-func (*MutationCreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ValidationFailed.ProtoReflect.Descriptor instead.
+func (*ValidationFailed) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *MutationCreatePostRequest) GetInput() *PostInput {
+func (x *ValidationFailed) GetId() string {
 	if x != nil {
-		return x.Input
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ValidationFailed) GetField() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Field
 	}
 	return nil
 }
 
-// Response message for createPost operation: Creates a new post.
-type MutationCreatePostResponse struct {
+func (x *ValidationFailed) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// The user has been changed since the version the input expected
+type VersionConflict struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Creates a new post
-	CreatePost    *Post `protobuf:"bytes,1,opt,name=create_post,json=createPost,proto3" json:"create_post,omitempty"`
+	// The ID given in the input
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The expected version given in the input
+	ExpectedVersion int32 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// The version of the stored user
+	CurrentVersion int32 `protobuf:"varint,3,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// Human-readable description of the failure
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MutationCreatePostResponse) Reset() {
-	*x = MutationCreatePostResponse{}
-	mi := &file_generated_service_proto_msgTypes[20]
+func (x *VersionConflict) Reset() {
+	*x = VersionConflict{}
+	mi := &file_generated_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MutationCreatePostResponse) String() string {
+func (x *VersionConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MutationCreatePostResponse) ProtoMessage() {}
+func (*VersionConflict) ProtoMessage() {}
 
-func (x *MutationCreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[20]
+func (x *VersionConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,61 +5874,67 @@ func (x *MutationCreatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MutationCreatePostResponse.ProtoReflect.Descriptor instead.
-func (*MutationCreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use VersionConflict.ProtoReflect.Descriptor instead.
+func (*VersionConflict) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *MutationCreatePostResponse) GetCreatePost() *Post {
+func (x *VersionConflict) GetId() string {
 	if x != nil {
-		return x.CreatePost
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type User struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The unique identifier for the user
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The user's name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The user's email address
-	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	// The user's role in the system
-	Role UserRole `protobuf:"varint,4,opt,name=role,proto3,enum=service.UserRole" json:"role,omitempty"`
-	// List of permissions granted to the user (always present, may be empty)
-	Permissions []string `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	// Optional list of tags associated with the user for categorization
-	Tags *ListOfString `protobuf:"bytes,6,opt,name=tags,proto3" json:"tags,omitempty"`
-	// Nested list: Skills organized by categories (e.g. [["JavaScript", "TypeScript"], ["React", "Vue"]])
-	SkillCategories *ListOfListOfString `protobuf:"bytes,7,opt,name=skill_categories,json=skillCategories,proto3" json:"skill_categories,omitempty"`
-	// Union type: Recent user activities
-	RecentActivity []*ActivityItem `protobuf:"bytes,8,rep,name=recent_activity,json=recentActivity,proto3" json:"recent_activity,omitempty"`
-	// Nullable complex type: User profile information
-	Profile *Profile `protobuf:"bytes,9,opt,name=profile,proto3" json:"profile,omitempty"`
-	// Nullable string: User biography
-	Bio *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=bio,proto3" json:"bio,omitempty"`
-	// Nullable integer: User age
-	Age           *wrapperspb.Int32Value `protobuf:"bytes,11,opt,name=age,proto3" json:"age,omitempty"`
+func (x *VersionConflict) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *VersionConflict) GetCurrentVersion() int32 {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return 0
+}
+
+func (x *VersionConflict) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// A page of audit records
+type AuditRecordConnection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The audit records on this page, each with its cursor
+	Edges []*AuditRecordEdge `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Information to fetch the next page
+	PageInfo *PageInfo `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	// Total number of audit records across all pages
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_generated_service_proto_msgTypes[21]
+func (x *AuditRecordConnection) Reset() {
+	*x = AuditRecordConnection{}
+	mi := &file_generated_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *AuditRecordConnection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*AuditRecordConnection) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[21]
+func (x *AuditRecordConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,117 +5945,121 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use AuditRecordConnection.ProtoReflect.Descriptor instead.
+func (*AuditRecordConnection) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *User) GetId() string {
+func (x *AuditRecordConnection) GetEdges() []*AuditRecordEdge {
 	if x != nil {
-		return x.Id
+		return x.Edges
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetName() string {
+func (x *AuditRecordConnection) GetPageInfo() *PageInfo {
 	if x != nil {
-		return x.Name
+		return x.PageInfo
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetEmail() string {
+func (x *AuditRecordConnection) GetTotalCount() int32 {
 	if x != nil {
-		return x.Email
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *User) GetRole() UserRole {
-	if x != nil {
-		return x.Role
-	}
-	return UserRole_USER_ROLE_UNSPECIFIED
+// An audit record on a page, together with the cursor pointing at it
+type AuditRecordEdge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The audit record
+	Node *AuditRecord `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Opaque cursor to pass as after
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) GetPermissions() []string {
-	if x != nil {
-		return x.Permissions
-	}
-	return nil
+func (x *AuditRecordEdge) Reset() {
+	*x = AuditRecordEdge{}
+	mi := &file_generated_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) GetTags() *ListOfString {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+func (x *AuditRecordEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetSkillCategories() *ListOfListOfString {
-	if x != nil {
-		return x.SkillCategories
-	}
-	return nil
-}
+func (*AuditRecordEdge) ProtoMessage() {}
 
-func (x *User) GetRecentActivity() []*ActivityItem {
+func (x *AuditRecordEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[91]
 	if x != nil {
-		return x.RecentActivity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetProfile() *Profile {
-	if x != nil {
-		return x.Profile
-	}
-	return nil
+// Deprecated: Use AuditRecordEdge.ProtoReflect.Descriptor instead.
+func (*AuditRecordEdge) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *User) GetBio() *wrapperspb.StringValue {
+func (x *AuditRecordEdge) GetNode() *AuditRecord {
 	if x != nil {
-		return x.Bio
+		return x.Node
 	}
 	return nil
 }
 
-func (x *User) GetAge() *wrapperspb.Int32Value {
+func (x *AuditRecordEdge) GetCursor() string {
 	if x != nil {
-		return x.Age
+		return x.Cursor
 	}
-	return nil
+	return ""
 }
 
-type ExternalUser struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                  `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                  `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	Phone         *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Website       *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
-	Company       *Company                `protobuf:"bytes,7,opt,name=company,proto3" json:"company,omitempty"`
-	Address       *Address                `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+// A change made to a user by a mutation
+type AuditRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier for the audit record
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the user the record belongs to
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The kind of change
+	Action AuditAction `protobuf:"varint,3,opt,name=action,proto3,enum=service.AuditAction" json:"action,omitempty"`
+	// Who made the change, taken from the request metadata. Null if the request didn't name an actor.
+	Actor *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Time of the change, in RFC 3339 format
+	Timestamp string `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The changed fields in schema order
+	Changes       []*FieldChange `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExternalUser) Reset() {
-	*x = ExternalUser{}
-	mi := &file_generated_service_proto_msgTypes[22]
+func (x *AuditRecord) Reset() {
+	*x = AuditRecord{}
+	mi := &file_generated_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExternalUser) String() string {
+func (x *AuditRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalUser) ProtoMessage() {}
+func (*AuditRecord) ProtoMessage() {}
 
-func (x *ExternalUser) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[22]
+func (x *AuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1261,94 +6070,145 @@ func (x *ExternalUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalUser.ProtoReflect.Descriptor instead.
-func (*ExternalUser) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use AuditRecord.ProtoReflect.Descriptor instead.
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ExternalUser) GetId() string {
+func (x *AuditRecord) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ExternalUser) GetName() string {
+func (x *AuditRecord) GetUserId() string {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ExternalUser) GetEmail() string {
+func (x *AuditRecord) GetAction() AuditAction {
 	if x != nil {
-		return x.Email
+		return x.Action
 	}
-	return ""
+	return AuditAction_AUDIT_ACTION_UNSPECIFIED
 }
 
-func (x *ExternalUser) GetUsername() string {
+func (x *AuditRecord) GetActor() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Username
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *AuditRecord) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
 	}
 	return ""
 }
 
-func (x *ExternalUser) GetPhone() *wrapperspb.StringValue {
+func (x *AuditRecord) GetChanges() []*FieldChange {
 	if x != nil {
-		return x.Phone
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *ExternalUser) GetWebsite() *wrapperspb.StringValue {
+// A field changed by a mutation
+type FieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path of the field, e.g. profile.timezone
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// JSON encoded value before the change, null if the field was null or didn't exist
+	OldValue *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// JSON encoded value after the change, null if the field is null or no longer exists
+	NewValue      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_generated_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[93]
 	if x != nil {
-		return x.Website
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ExternalUser) GetCompany() *Company {
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *FieldChange) GetField() string {
 	if x != nil {
-		return x.Company
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.OldValue
 	}
 	return nil
 }
 
-func (x *ExternalUser) GetAddress() *Address {
+func (x *FieldChange) GetNewValue() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Address
+		return x.NewValue
 	}
 	return nil
 }
 
-// Union type representing different types of user activities
-type ActivityItem struct {
+// A page of activity items
+type ActivityConnection struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Value:
-	//
-	//	*ActivityItem_Post
-	//	*ActivityItem_Comment
-	Value         isActivityItem_Value `protobuf_oneof:"value"`
+	// The activity items on this page, each with its cursor
+	Edges []*ActivityEdge `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Information to fetch the next page
+	PageInfo *PageInfo `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	// Total number of activity items across all pages
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivityItem) Reset() {
-	*x = ActivityItem{}
-	mi := &file_generated_service_proto_msgTypes[23]
+func (x *ActivityConnection) Reset() {
+	*x = ActivityConnection{}
+	mi := &file_generated_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivityItem) String() string {
+func (x *ActivityConnection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityItem) ProtoMessage() {}
+func (*ActivityConnection) ProtoMessage() {}
 
-func (x *ActivityItem) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[23]
+func (x *ActivityConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1359,85 +6219,112 @@ func (x *ActivityItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityItem.ProtoReflect.Descriptor instead.
-func (*ActivityItem) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ActivityConnection.ProtoReflect.Descriptor instead.
+func (*ActivityConnection) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *ActivityItem) GetValue() isActivityItem_Value {
+func (x *ActivityConnection) GetEdges() []*ActivityEdge {
 	if x != nil {
-		return x.Value
+		return x.Edges
 	}
 	return nil
 }
 
-func (x *ActivityItem) GetPost() *Post {
+func (x *ActivityConnection) GetPageInfo() *PageInfo {
 	if x != nil {
-		if x, ok := x.Value.(*ActivityItem_Post); ok {
-			return x.Post
-		}
+		return x.PageInfo
 	}
 	return nil
 }
 
-func (x *ActivityItem) GetComment() *Comment {
+func (x *ActivityConnection) GetTotalCount() int32 {
 	if x != nil {
-		if x, ok := x.Value.(*ActivityItem_Comment); ok {
-			return x.Comment
-		}
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-type isActivityItem_Value interface {
-	isActivityItem_Value()
+// An activity item on a page, together with the cursor pointing at it
+type ActivityEdge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The post or comment
+	Node *ActivityItem `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Opaque cursor to pass as after
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type ActivityItem_Post struct {
-	// A simple post by a user
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3,oneof"`
+func (x *ActivityEdge) Reset() {
+	*x = ActivityEdge{}
+	mi := &file_generated_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type ActivityItem_Comment struct {
-	// A comment by a user
-	Comment *Comment `protobuf:"bytes,2,opt,name=comment,proto3,oneof"`
+func (x *ActivityEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityItem_Post) isActivityItem_Value() {}
+func (*ActivityEdge) ProtoMessage() {}
 
-func (*ActivityItem_Comment) isActivityItem_Value() {}
+func (x *ActivityEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-type UserInput struct {
-	state           protoimpl.MessageState  `protogen:"open.v1"`
-	Id              string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email           *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Role            UserRole                `protobuf:"varint,4,opt,name=role,proto3,enum=service.UserRole" json:"role,omitempty"`
-	Permissions     *ListOfString           `protobuf:"bytes,5,opt,name=permissions,proto3" json:"permissions,omitempty"`
-	Tags            *ListOfString           `protobuf:"bytes,6,opt,name=tags,proto3" json:"tags,omitempty"`
-	SkillCategories *ListOfListOfString     `protobuf:"bytes,7,opt,name=skill_categories,json=skillCategories,proto3" json:"skill_categories,omitempty"`
-	Bio             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=bio,proto3" json:"bio,omitempty"`
-	Age             *wrapperspb.Int32Value  `protobuf:"bytes,9,opt,name=age,proto3" json:"age,omitempty"`
-	Profile         *ProfileInput           `protobuf:"bytes,10,opt,name=profile,proto3" json:"profile,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Deprecated: Use ActivityEdge.ProtoReflect.Descriptor instead.
+func (*ActivityEdge) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *UserInput) Reset() {
-	*x = UserInput{}
-	mi := &file_generated_service_proto_msgTypes[24]
+func (x *ActivityEdge) GetNode() *ActivityItem {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ActivityEdge) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Input type for creating comments
+type CommentInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentInput) Reset() {
+	*x = CommentInput{}
+	mi := &file_generated_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserInput) String() string {
+func (x *CommentInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInput) ProtoMessage() {}
+func (*CommentInput) ProtoMessage() {}
 
-func (x *UserInput) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[24]
+func (x *CommentInput) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,105 +6335,111 @@ func (x *UserInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInput.ProtoReflect.Descriptor instead.
-func (*UserInput) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CommentInput.ProtoReflect.Descriptor instead.
+func (*CommentInput) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *UserInput) GetId() string {
+func (x *CommentInput) GetPostId() string {
 	if x != nil {
-		return x.Id
+		return x.PostId
 	}
 	return ""
 }
 
-func (x *UserInput) GetName() *wrapperspb.StringValue {
+func (x *CommentInput) GetAuthorId() string {
 	if x != nil {
-		return x.Name
+		return x.AuthorId
 	}
-	return nil
+	return ""
 }
 
-func (x *UserInput) GetEmail() *wrapperspb.StringValue {
+func (x *CommentInput) GetContent() string {
 	if x != nil {
-		return x.Email
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-func (x *UserInput) GetRole() UserRole {
-	if x != nil {
-		return x.Role
-	}
-	return UserRole_USER_ROLE_UNSPECIFIED
+// Input type for editing comments
+type UpdateCommentInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserInput) GetPermissions() *ListOfString {
-	if x != nil {
-		return x.Permissions
-	}
-	return nil
+func (x *UpdateCommentInput) Reset() {
+	*x = UpdateCommentInput{}
+	mi := &file_generated_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserInput) GetTags() *ListOfString {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+func (x *UpdateCommentInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInput) GetSkillCategories() *ListOfListOfString {
+func (*UpdateCommentInput) ProtoMessage() {}
+
+func (x *UpdateCommentInput) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[97]
 	if x != nil {
-		return x.SkillCategories
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UserInput) GetBio() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Bio
-	}
-	return nil
+// Deprecated: Use UpdateCommentInput.ProtoReflect.Descriptor instead.
+func (*UpdateCommentInput) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *UserInput) GetAge() *wrapperspb.Int32Value {
+func (x *UpdateCommentInput) GetId() string {
 	if x != nil {
-		return x.Age
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *UserInput) GetProfile() *ProfileInput {
+func (x *UpdateCommentInput) GetContent() string {
 	if x != nil {
-		return x.Profile
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-// Input type for creating posts
-type PostInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	AuthorId      string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+// Filter for post lists. All conditions that are set must match.
+type PostFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The post was written by the given user
+	AuthorId *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// The post's status is one of the given statuses
+	StatusIn      *ListOfPostStatus `protobuf:"bytes,2,opt,name=status_in,json=statusIn,proto3" json:"status_in,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PostInput) Reset() {
-	*x = PostInput{}
-	mi := &file_generated_service_proto_msgTypes[25]
+func (x *PostFilter) Reset() {
+	*x = PostFilter{}
+	mi := &file_generated_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostInput) String() string {
+func (x *PostFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostInput) ProtoMessage() {}
+func (*PostFilter) ProtoMessage() {}
 
-func (x *PostInput) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[25]
+func (x *PostFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1557,53 +6450,50 @@ func (x *PostInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostInput.ProtoReflect.Descriptor instead.
-func (*PostInput) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PostFilter.ProtoReflect.Descriptor instead.
+func (*PostFilter) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *PostInput) GetTitle() string {
+func (x *PostFilter) GetAuthorId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Title
+		return x.AuthorId
 	}
-	return ""
+	return nil
 }
 
-func (x *PostInput) GetAuthorId() string {
+func (x *PostFilter) GetStatusIn() *ListOfPostStatus {
 	if x != nil {
-		return x.AuthorId
+		return x.StatusIn
 	}
-	return ""
+	return nil
 }
 
-// A simple post by a user
-type Post struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The unique identifier for the post
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Post title
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// Post author ID
-	AuthorId      string `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+// Input type for editing posts. Fields that are omitted or null keep their value.
+type UpdatePostInput struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status        PostStatus              `protobuf:"varint,3,opt,name=status,proto3,enum=service.PostStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
-	mi := &file_generated_service_proto_msgTypes[26]
+func (x *UpdatePostInput) Reset() {
+	*x = UpdatePostInput{}
+	mi := &file_generated_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) String() string {
+func (x *UpdatePostInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*UpdatePostInput) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[26]
+func (x *UpdatePostInput) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,60 +6504,60 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use UpdatePostInput.ProtoReflect.Descriptor instead.
+func (*UpdatePostInput) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *Post) GetId() string {
+func (x *UpdatePostInput) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Post) GetTitle() string {
+func (x *UpdatePostInput) GetTitle() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Title
 	}
-	return ""
+	return nil
 }
 
-func (x *Post) GetAuthorId() string {
+func (x *UpdatePostInput) GetStatus() PostStatus {
 	if x != nil {
-		return x.AuthorId
+		return x.Status
 	}
-	return ""
+	return PostStatus_POST_STATUS_UNSPECIFIED
 }
 
-// Interface for entities with unique identifiers
-type Node struct {
+// A page of posts
+type PostConnection struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Instance:
-	//
-	//	*Node_User
-	//	*Node_Post
-	//	*Node_Comment
-	Instance      isNode_Instance `protobuf_oneof:"instance"`
+	// The posts on this page, each with its cursor
+	Edges []*PostEdge `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Information to fetch the next page
+	PageInfo *PageInfo `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	// Total number of posts across all pages
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Node) Reset() {
-	*x = Node{}
-	mi := &file_generated_service_proto_msgTypes[27]
+func (x *PostConnection) Reset() {
+	*x = PostConnection{}
+	mi := &file_generated_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Node) String() string {
+func (x *PostConnection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Node) ProtoMessage() {}
+func (*PostConnection) ProtoMessage() {}
 
-func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[27]
+func (x *PostConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1678,97 +6568,109 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Node.ProtoReflect.Descriptor instead.
-func (*Node) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use PostConnection.ProtoReflect.Descriptor instead.
+func (*PostConnection) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *Node) GetInstance() isNode_Instance {
+func (x *PostConnection) GetEdges() []*PostEdge {
 	if x != nil {
-		return x.Instance
+		return x.Edges
 	}
 	return nil
 }
 
-func (x *Node) GetUser() *User {
+func (x *PostConnection) GetPageInfo() *PageInfo {
 	if x != nil {
-		if x, ok := x.Instance.(*Node_User); ok {
-			return x.User
-		}
+		return x.PageInfo
 	}
 	return nil
 }
 
-func (x *Node) GetPost() *Post {
+func (x *PostConnection) GetTotalCount() int32 {
 	if x != nil {
-		if x, ok := x.Instance.(*Node_Post); ok {
-			return x.Post
-		}
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Node) GetComment() *Comment {
-	if x != nil {
-		if x, ok := x.Instance.(*Node_Comment); ok {
-			return x.Comment
-		}
-	}
-	return nil
+// A post on a page, together with the cursor pointing at it
+type PostEdge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The post
+	Node *Post `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Opaque cursor to pass as after
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type isNode_Instance interface {
-	isNode_Instance()
+func (x *PostEdge) Reset() {
+	*x = PostEdge{}
+	mi := &file_generated_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type Node_User struct {
-	User *User `protobuf:"bytes,1,opt,name=user,proto3,oneof"`
+func (x *PostEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Node_Post struct {
-	// A simple post by a user
-	Post *Post `protobuf:"bytes,2,opt,name=post,proto3,oneof"`
-}
+func (*PostEdge) ProtoMessage() {}
 
-type Node_Comment struct {
-	// A comment by a user
-	Comment *Comment `protobuf:"bytes,3,opt,name=comment,proto3,oneof"`
+func (x *PostEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*Node_User) isNode_Instance() {}
+// Deprecated: Use PostEdge.ProtoReflect.Descriptor instead.
+func (*PostEdge) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{101}
+}
 
-func (*Node_Post) isNode_Instance() {}
+func (x *PostEdge) GetNode() *Post {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
 
-func (*Node_Comment) isNode_Instance() {}
+func (x *PostEdge) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
 
-// User profile information (nullable complex type)
-type Profile struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// User's display name preference
-	DisplayName *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// User's timezone preference
-	Timezone *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	// Theme preference
-	Theme         Theme `protobuf:"varint,3,opt,name=theme,proto3,enum=service.Theme" json:"theme,omitempty"`
+type ListOfActivityType_List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []ActivityType         `protobuf:"varint,1,rep,packed,name=items,proto3,enum=service.ActivityType" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Profile) Reset() {
-	*x = Profile{}
-	mi := &file_generated_service_proto_msgTypes[28]
+func (x *ListOfActivityType_List) Reset() {
+	*x = ListOfActivityType_List{}
+	mi := &file_generated_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Profile) String() string {
+func (x *ListOfActivityType_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Profile) ProtoMessage() {}
+func (*ListOfActivityType_List) ProtoMessage() {}
 
-func (x *Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[28]
+func (x *ListOfActivityType_List) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1779,60 +6681,40 @@ func (x *Profile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
-func (*Profile) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *Profile) GetDisplayName() *wrapperspb.StringValue {
-	if x != nil {
-		return x.DisplayName
-	}
-	return nil
+// Deprecated: Use ListOfActivityType_List.ProtoReflect.Descriptor instead.
+func (*ListOfActivityType_List) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{0, 0}
 }
 
-func (x *Profile) GetTimezone() *wrapperspb.StringValue {
+func (x *ListOfActivityType_List) GetItems() []ActivityType {
 	if x != nil {
-		return x.Timezone
+		return x.Items
 	}
 	return nil
 }
 
-func (x *Profile) GetTheme() Theme {
-	if x != nil {
-		return x.Theme
-	}
-	return Theme_THEME_UNSPECIFIED
-}
-
-// A comment by a user
-type Comment struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The unique identifier for the comment
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Comment content
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	// Comment author ID
-	AuthorId      string `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+type ListOfListOfString_List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ListOfString        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
-	mi := &file_generated_service_proto_msgTypes[29]
+func (x *ListOfListOfString_List) Reset() {
+	*x = ListOfListOfString_List{}
+	mi := &file_generated_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Comment) String() string {
+func (x *ListOfListOfString_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*ListOfListOfString_List) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[29]
+func (x *ListOfListOfString_List) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1843,56 +6725,84 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListOfListOfString_List.ProtoReflect.Descriptor instead.
+func (*ListOfListOfString_List) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{1, 0}
 }
 
-func (x *Comment) GetId() string {
+func (x *ListOfListOfString_List) GetItems() []*ListOfString {
 	if x != nil {
-		return x.Id
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *Comment) GetContent() string {
+type ListOfNullableProfileField_List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []NullableProfileField `protobuf:"varint,1,rep,packed,name=items,proto3,enum=service.NullableProfileField" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOfNullableProfileField_List) Reset() {
+	*x = ListOfNullableProfileField_List{}
+	mi := &file_generated_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOfNullableProfileField_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOfNullableProfileField_List) ProtoMessage() {}
+
+func (x *ListOfNullableProfileField_List) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[104]
 	if x != nil {
-		return x.Content
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Comment) GetAuthorId() string {
+// Deprecated: Use ListOfNullableProfileField_List.ProtoReflect.Descriptor instead.
+func (*ListOfNullableProfileField_List) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *ListOfNullableProfileField_List) GetItems() []NullableProfileField {
 	if x != nil {
-		return x.AuthorId
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type Company struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CatchPhrase   *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=catch_phrase,json=catchPhrase,proto3" json:"catch_phrase,omitempty"`
-	Bs            *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=bs,proto3" json:"bs,omitempty"`
+type ListOfNullableUserField_List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []NullableUserField    `protobuf:"varint,1,rep,packed,name=items,proto3,enum=service.NullableUserField" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Company) Reset() {
-	*x = Company{}
-	mi := &file_generated_service_proto_msgTypes[30]
+func (x *ListOfNullableUserField_List) Reset() {
+	*x = ListOfNullableUserField_List{}
+	mi := &file_generated_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Company) String() string {
+func (x *ListOfNullableUserField_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Company) ProtoMessage() {}
+func (*ListOfNullableUserField_List) ProtoMessage() {}
 
-func (x *Company) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[30]
+func (x *ListOfNullableUserField_List) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,59 +6813,40 @@ func (x *Company) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Company.ProtoReflect.Descriptor instead.
-func (*Company) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *Company) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Company) GetCatchPhrase() *wrapperspb.StringValue {
-	if x != nil {
-		return x.CatchPhrase
-	}
-	return nil
+// Deprecated: Use ListOfNullableUserField_List.ProtoReflect.Descriptor instead.
+func (*ListOfNullableUserField_List) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{3, 0}
 }
 
-func (x *Company) GetBs() *wrapperspb.StringValue {
+func (x *ListOfNullableUserField_List) GetItems() []NullableUserField {
 	if x != nil {
-		return x.Bs
+		return x.Items
 	}
 	return nil
 }
 
-type Address struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Street        *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
-	Suite         *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=suite,proto3" json:"suite,omitempty"`
-	City          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	Zipcode       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=zipcode,proto3" json:"zipcode,omitempty"`
-	Geo           *Geo                    `protobuf:"bytes,5,opt,name=geo,proto3" json:"geo,omitempty"`
-	Test          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=test,proto3" json:"test,omitempty"`
+type ListOfPostStatus_List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []PostStatus           `protobuf:"varint,1,rep,packed,name=items,proto3,enum=service.PostStatus" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Address) Reset() {
-	*x = Address{}
-	mi := &file_generated_service_proto_msgTypes[31]
+func (x *ListOfPostStatus_List) Reset() {
+	*x = ListOfPostStatus_List{}
+	mi := &file_generated_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Address) String() string {
+func (x *ListOfPostStatus_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Address) ProtoMessage() {}
+func (*ListOfPostStatus_List) ProtoMessage() {}
 
-func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[31]
+func (x *ListOfPostStatus_List) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1966,76 +6857,84 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Address.ProtoReflect.Descriptor instead.
-func (*Address) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ListOfPostStatus_List.ProtoReflect.Descriptor instead.
+func (*ListOfPostStatus_List) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{4, 0}
 }
 
-func (x *Address) GetStreet() *wrapperspb.StringValue {
+func (x *ListOfPostStatus_List) GetItems() []PostStatus {
 	if x != nil {
-		return x.Street
+		return x.Items
 	}
 	return nil
 }
 
-func (x *Address) GetSuite() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Suite
-	}
-	return nil
+type ListOfSearchableType_List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []SearchableType       `protobuf:"varint,1,rep,packed,name=items,proto3,enum=service.SearchableType" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Address) GetCity() *wrapperspb.StringValue {
-	if x != nil {
-		return x.City
-	}
-	return nil
+func (x *ListOfSearchableType_List) Reset() {
+	*x = ListOfSearchableType_List{}
+	mi := &file_generated_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Address) GetZipcode() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Zipcode
-	}
-	return nil
+func (x *ListOfSearchableType_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Address) GetGeo() *Geo {
+func (*ListOfSearchableType_List) ProtoMessage() {}
+
+func (x *ListOfSearchableType_List) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[107]
 	if x != nil {
-		return x.Geo
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Address) GetTest() *wrapperspb.StringValue {
+// Deprecated: Use ListOfSearchableType_List.ProtoReflect.Descriptor instead.
+func (*ListOfSearchableType_List) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *ListOfSearchableType_List) GetItems() []SearchableType {
 	if x != nil {
-		return x.Test
+		return x.Items
 	}
 	return nil
 }
 
-type Geo struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Lat           *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=lat,proto3" json:"lat,omitempty"`
-	Lng           *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=lng,proto3" json:"lng,omitempty"`
+type ListOfString_List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []string               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Geo) Reset() {
-	*x = Geo{}
-	mi := &file_generated_service_proto_msgTypes[32]
+func (x *ListOfString_List) Reset() {
+	*x = ListOfString_List{}
+	mi := &file_generated_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Geo) String() string {
+func (x *ListOfString_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Geo) ProtoMessage() {}
+func (*ListOfString_List) ProtoMessage() {}
 
-func (x *Geo) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[32]
+func (x *ListOfString_List) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2046,50 +6945,40 @@ func (x *Geo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Geo.ProtoReflect.Descriptor instead.
-func (*Geo) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *Geo) GetLat() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Lat
-	}
-	return nil
+// Deprecated: Use ListOfString_List.ProtoReflect.Descriptor instead.
+func (*ListOfString_List) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{6, 0}
 }
 
-func (x *Geo) GetLng() *wrapperspb.StringValue {
+func (x *ListOfString_List) GetItems() []string {
 	if x != nil {
-		return x.Lng
+		return x.Items
 	}
 	return nil
 }
 
-// Input type for user profile updates
-type ProfileInput struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	DisplayName   *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Timezone      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	Theme         Theme                   `protobuf:"varint,3,opt,name=theme,proto3,enum=service.Theme" json:"theme,omitempty"`
+type ListOfUserFilter_List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*UserFilter          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfileInput) Reset() {
-	*x = ProfileInput{}
-	mi := &file_generated_service_proto_msgTypes[33]
+func (x *ListOfUserFilter_List) Reset() {
+	*x = ListOfUserFilter_List{}
+	mi := &file_generated_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfileInput) String() string {
+func (x *ListOfUserFilter_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileInput) ProtoMessage() {}
+func (*ListOfUserFilter_List) ProtoMessage() {}
 
-func (x *ProfileInput) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[33]
+func (x *ListOfUserFilter_List) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2100,54 +6989,40 @@ func (x *ProfileInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileInput.ProtoReflect.Descriptor instead.
-func (*ProfileInput) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *ProfileInput) GetDisplayName() *wrapperspb.StringValue {
-	if x != nil {
-		return x.DisplayName
-	}
-	return nil
+// Deprecated: Use ListOfUserFilter_List.ProtoReflect.Descriptor instead.
+func (*ListOfUserFilter_List) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{7, 0}
 }
 
-func (x *ProfileInput) GetTimezone() *wrapperspb.StringValue {
+func (x *ListOfUserFilter_List) GetItems() []*UserFilter {
 	if x != nil {
-		return x.Timezone
+		return x.Items
 	}
 	return nil
 }
 
-func (x *ProfileInput) GetTheme() Theme {
-	if x != nil {
-		return x.Theme
-	}
-	return Theme_THEME_UNSPECIFIED
-}
-
-type ListOfListOfString_List struct {
+type ListOfUserOrder_List struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*ListOfString        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Items         []*UserOrder           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOfListOfString_List) Reset() {
-	*x = ListOfListOfString_List{}
-	mi := &file_generated_service_proto_msgTypes[34]
+func (x *ListOfUserOrder_List) Reset() {
+	*x = ListOfUserOrder_List{}
+	mi := &file_generated_service_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOfListOfString_List) String() string {
+func (x *ListOfUserOrder_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOfListOfString_List) ProtoMessage() {}
+func (*ListOfUserOrder_List) ProtoMessage() {}
 
-func (x *ListOfListOfString_List) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[34]
+func (x *ListOfUserOrder_List) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2158,40 +7033,40 @@ func (x *ListOfListOfString_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOfListOfString_List.ProtoReflect.Descriptor instead.
-func (*ListOfListOfString_List) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use ListOfUserOrder_List.ProtoReflect.Descriptor instead.
+func (*ListOfUserOrder_List) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{8, 0}
 }
 
-func (x *ListOfListOfString_List) GetItems() []*ListOfString {
+func (x *ListOfUserOrder_List) GetItems() []*UserOrder {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-type ListOfString_List struct {
+type ListOfUserRole_List struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []string               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Items         []UserRole             `protobuf:"varint,1,rep,packed,name=items,proto3,enum=service.UserRole" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOfString_List) Reset() {
-	*x = ListOfString_List{}
-	mi := &file_generated_service_proto_msgTypes[35]
+func (x *ListOfUserRole_List) Reset() {
+	*x = ListOfUserRole_List{}
+	mi := &file_generated_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOfString_List) String() string {
+func (x *ListOfUserRole_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOfString_List) ProtoMessage() {}
+func (*ListOfUserRole_List) ProtoMessage() {}
 
-func (x *ListOfString_List) ProtoReflect() protoreflect.Message {
-	mi := &file_generated_service_proto_msgTypes[35]
+func (x *ListOfUserRole_List) ProtoReflect() protoreflect.Message {
+	mi := &file_generated_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2202,12 +7077,12 @@ func (x *ListOfString_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOfString_List.ProtoReflect.Descriptor instead.
-func (*ListOfString_List) Descriptor() ([]byte, []int) {
-	return file_generated_service_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use ListOfUserRole_List.ProtoReflect.Descriptor instead.
+func (*ListOfUserRole_List) Descriptor() ([]byte, []int) {
+	return file_generated_service_proto_rawDescGZIP(), []int{9, 0}
 }
 
-func (x *ListOfString_List) GetItems() []string {
+func (x *ListOfUserRole_List) GetItems() []UserRole {
 	if x != nil {
 		return x.Items
 	}
@@ -2221,329 +7096,1107 @@ var file_generated_service_proto_rawDesc = []byte{
 	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x7f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x74, 0x6f, 0x22, 0x7f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x33,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x33,
 	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x22, 0x5c, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c,
-	0x69, 0x73, 0x74, 0x1a, 0x1c, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x22, 0x2a, 0x0a, 0x18, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x42,
-	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a,
-	0x15, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
-	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x3f, 0x0a,
-	0x16, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x13,
-	0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x22,
-	0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x36, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x57, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d,
-	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x7f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a,
+	0x33, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4e,
+	0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x3c, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x66, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x1a, 0x3b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x8e,
+	0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x38, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x79, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x31, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x66, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x35, 0x0a, 0x04, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x5c, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x1a, 0x1c, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x79, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x31, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x76, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x31,
+	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x73, 0x65,
+	0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x1a, 0x30, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x73, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x2f, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x15, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55, 0x73,
+	0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x22, 0x3f, 0x0a, 0x16, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55, 0x73,
+	0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2a, 0x0a, 0x18, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50,
+	0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x4e, 0x0a, 0x15, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x22, 0x3f, 0x0a, 0x16, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x2d, 0x0a, 0x1b, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x54, 0x0a, 0x18, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65,
+	0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x45, 0x0a, 0x19, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xba,
+	0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x39, 0x0a, 0x12, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x67, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22,
+	0x36, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x22, 0x2a, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x22, 0x93, 0x02, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x43,
+	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x57, 0x0a, 0x19, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x22, 0x92, 0x03, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x6c,
+	0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x62, 0x0a, 0x1c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01,
+	0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x31, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x22, 0x44, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x99, 0x01, 0x0a, 0x17, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0x57, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
-	0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x75, 0x73, 0x65,
-	0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22, 0x45, 0x0a, 0x19, 0x4d, 0x75, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x12, 0x32, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x22, 0x84, 0x03, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x32, 0x0a,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x12, 0x32, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x43, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x7c, 0x0a, 0x23, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x18, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x16, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x11,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04,
+	0x70, 0x6f, 0x73, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x43,
+	0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x19, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x4c, 0x0a, 0x1a, 0x4d, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x22, 0x7a, 0x0a, 0x1a, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74,
-	0x22, 0x4c, 0x0a, 0x1a, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x12, 0x32, 0x0a, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x61, 0x74,
+	0x6f, 0x6d, 0x69, 0x63, 0x22, 0x5d, 0x0a, 0x1b, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x22, 0x45, 0x0a, 0x19, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x4c, 0x0a, 0x1a, 0x4d, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x19, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x4c, 0x0a, 0x1a, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x22, 0x2b, 0x0a, 0x19, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x4c, 0x0a, 0x1a, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
-	0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x22, 0x46,
-	0x0a, 0x1a, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52,
-	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x4f, 0x0a, 0x1b, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x45, 0x0a, 0x19, 0x4d, 0x75, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f,
-	0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x4c,
-	0x0a, 0x1a, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0b,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x22, 0xc7, 0x03, 0x0a,
-	0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x74,
-	0x61, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x6c,
-	0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0f, 0x72,
-	0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0e, 0x72, 0x65, 0x63,
-	0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x0a,
+	0x65, 0x72, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x22, 0x2c,
+	0x0a, 0x1a, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x1b,
+	0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x22, 0x4b, 0x0a,
+	0x1c, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x58, 0x0a, 0x1d, 0x4d, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x1c, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x58, 0x0a, 0x1d, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x2e, 0x0a, 0x1c, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x58, 0x0a, 0x1d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x19, 0x4d,
+	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x4c, 0x0a, 0x1a, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x19, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x1a, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x22, 0xbd, 0x04, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x6c,
+	0x6c, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x3e, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x03,
+	0x62, 0x69, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x2d, 0x0a, 0x03,
+	0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x8a, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa8,
+	0x02, 0x0a, 0x0c, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x2d, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x03, 0x61, 0x67, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x0c, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a,
-	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x12, 0x36, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x07, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x6a, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x00,
-	0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe4, 0x03,
-	0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
-	0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x10,
-	0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x75, 0x65, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x77, 0x65, 0x62,
+	0x73, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
+	0x65, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x2a, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x6a, 0x0a, 0x0c, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe4, 0x04, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x03, 0x62, 0x69, 0x6f, 0x12, 0x2d, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03,
-	0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x22, 0x3e, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x22,
-	0x8a, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a,
-	0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f,
-	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x42, 0x0a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xaa, 0x01, 0x0a,
-	0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70,
-	0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x12, 0x37, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x73, 0x6b, 0x69,
+	0x6c, 0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x03,
+	0x62, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x2d, 0x0a, 0x03,
+	0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x05,
+	0x75, 0x6e, 0x73, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4e, 0x75, 0x6c, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x75,
+	0x6e, 0x73, 0x65, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x69, 0x6d,
-	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
-	0x6f, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x68, 0x65,
-	0x6d, 0x65, 0x52, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x07,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x63,
-	0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0b, 0x63, 0x61, 0x74, 0x63, 0x68, 0x50, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02,
-	0x62, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x09,
+	0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xaa, 0x03, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30,
+	0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x6e,
+	0x12, 0x41, 0x0a, 0x11, 0x74, 0x61, 0x67, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x5f, 0x61, 0x6e, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x0f, 0x74, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+	0x41, 0x6e, 0x79, 0x12, 0x4f, 0x0a, 0x18, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x16, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x41, 0x6c, 0x6c, 0x12, 0x23, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x6b, 0x69,
+	0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x62, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x07, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x05,
-	0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65,
-	0x12, 0x30, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x2b, 0x0a,
+	0x03, 0x61, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x03, 0x61, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x02, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x02, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x03, 0x6e, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x22, 0xc2, 0x01, 0x0a,
+	0x08, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x02, 0x67, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2d, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x03, 0x67, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
+	0x6c, 0x74, 0x12, 0x2d, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x74,
+	0x65, 0x22, 0x6b, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9f,
+	0x02, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2b, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x8a, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x70,
+	0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x0a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xaa, 0x01,
+	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x68,
+	0x65, 0x6d, 0x65, 0x52, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x07, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x8f, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x68, 0x72,
+	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63, 0x61, 0x74, 0x63, 0x68, 0x50, 0x68,
+	0x72, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x62, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
+	0x62, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x63, 0x69,
-	0x74, 0x79, 0x12, 0x36, 0x0a, 0x07, 0x7a, 0x69, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74,
+	0x72, 0x65, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x07, 0x7a, 0x69, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x67, 0x65,
-	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x47, 0x65, 0x6f, 0x52, 0x03, 0x67, 0x65, 0x6f, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x65,
-	0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x07, 0x7a, 0x69,
+	0x70, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x7a, 0x69, 0x70, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x67, 0x65, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x52, 0x03, 0x67,
+	0x65, 0x6f, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
+	0x74, 0x65, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x03, 0x47, 0x65, 0x6f, 0x12, 0x2e, 0x0a, 0x03, 0x6c,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x74, 0x65, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x03,
-	0x47, 0x65, 0x6f, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6c,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6c, 0x6e, 0x67, 0x22, 0x9e, 0x03, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x12, 0x37, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x73, 0x6b, 0x69,
+	0x6c, 0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x03,
+	0x62, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x2d, 0x0a, 0x03,
+	0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xea, 0x01, 0x0a,
+	0x0c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x3f, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03,
-	0x6c, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x39,
+	0x0a, 0x05, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4e, 0x75,
+	0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x05, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x22, 0x45, 0x0a, 0x08, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x64, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0xd8, 0x01, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a,
+	0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61,
+	0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a,
+	0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3b,
+	0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x09, 0x65, 0x6e, 0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xa6, 0x02, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74,
+	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74,
+	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x48, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x10, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
+	0x45, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x30, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x22, 0x38, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x70, 0x0a, 0x10, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x32, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8f, 0x01,
+	0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x98, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x64, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x64,
+	0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x53, 0x0a, 0x0f, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x64, 0x67, 0x65, 0x12, 0x28, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0xe6, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x39,
+	0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x6e, 0x65, 0x77,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x05, 0x65,
+	0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x45, 0x64, 0x67,
+	0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x0c, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x45, 0x64, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x5e, 0x0a, 0x0c,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x7f, 0x0a, 0x0a,
+	0x50, 0x6f, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x22, 0x82, 0x01,
+	0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03,
-	0x6c, 0x6e, 0x67, 0x22, 0xaf, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
-	0x24, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x05,
-	0x74, 0x68, 0x65, 0x6d, 0x65, 0x2a, 0x4f, 0x0a, 0x05, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x15,
-	0x0a, 0x11, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x4c,
-	0x49, 0x47, 0x48, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x5f,
-	0x44, 0x41, 0x52, 0x4b, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x5f,
-	0x41, 0x55, 0x54, 0x4f, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
-	0x6c, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a,
-	0x0f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e,
-	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
-	0x55, 0x53, 0x45, 0x52, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52,
-	0x4f, 0x4c, 0x45, 0x5f, 0x47, 0x55, 0x45, 0x53, 0x54, 0x10, 0x03, 0x32, 0xb5, 0x06, 0x0a, 0x0c,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0e,
-	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1e,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55,
-	0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55,
-	0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5f, 0x0a, 0x12, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x2e,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x45, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x2a, 0xb1, 0x01, 0x0a, 0x11, 0x4e, 0x75, 0x6c, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x1f,
+	0x4e, 0x55, 0x4c, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4e, 0x55, 0x4c, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x41, 0x47, 0x53, 0x10, 0x01, 0x12,
+	0x1b, 0x0a, 0x17, 0x4e, 0x55, 0x4c, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x42, 0x49, 0x4f, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17,
+	0x4e, 0x55, 0x4c, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x41, 0x47, 0x45, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x55, 0x4c,
+	0x4c, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x04, 0x2a, 0xb7, 0x01, 0x0a, 0x0e, 0x55,
+	0x73, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a,
+	0x1c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c,
+	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x03, 0x12,
+	0x18, 0x0a, 0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x41, 0x47, 0x45, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x52, 0x4f,
+	0x4c, 0x45, 0x10, 0x05, 0x2a, 0x64, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01,
+	0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x2a, 0x82, 0x01, 0x0a, 0x0e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
+	0x1b, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x41, 0x52,
+	0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54,
+	0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x2a,
+	0x4f, 0x0a, 0x05, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x48, 0x45, 0x4d,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x01,
+	0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x44, 0x41, 0x52, 0x4b, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x03,
+	0x2a, 0x63, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x15,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x02,
+	0x12, 0x13, 0x0a, 0x0f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x47, 0x55,
+	0x45, 0x53, 0x54, 0x10, 0x03, 0x2a, 0xae, 0x01, 0x0a, 0x14, 0x4e, 0x75, 0x6c, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x26,
+	0x0a, 0x22, 0x4e, 0x55, 0x4c, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49,
+	0x4c, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x4e, 0x55, 0x4c, 0x4c, 0x41, 0x42,
+	0x4c, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x5f, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12,
+	0x23, 0x0a, 0x1f, 0x4e, 0x55, 0x4c, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x46,
+	0x49, 0x4c, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5a, 0x4f,
+	0x4e, 0x45, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x55, 0x4c, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54,
+	0x48, 0x45, 0x4d, 0x45, 0x10, 0x03, 0x2a, 0xe1, 0x02, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x02,
+	0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x03, 0x12, 0x1c,
+	0x0a, 0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19,
+	0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53,
+	0x54, 0x4f, 0x52, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x41,
+	0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x1f, 0x0a, 0x1b,
+	0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x1f, 0x0a,
+	0x1b, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x08, 0x12, 0x1c,
+	0x0a, 0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x09, 0x12, 0x1c, 0x0a, 0x18,
+	0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x0a, 0x2a, 0x60, 0x0a, 0x0c, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x10,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0x75, 0x0a, 0x0a,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x4f,
+	0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x4f, 0x53, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x01, 0x12, 0x19,
+	0x0a, 0x15, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x55,
+	0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4f, 0x53,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45,
+	0x44, 0x10, 0x03, 0x32, 0xa7, 0x12, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x73, 0x74,
+	0x42, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15,
+	0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x73,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a,
+	0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55,
 	0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75,
 	0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x21, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x77, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a,
+	0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x25, 0x5a,
+	0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x2f, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2558,132 +8211,378 @@ func file_generated_service_proto_rawDescGZIP() []byte {
 	return file_generated_service_proto_rawDescData
 }
 
-var file_generated_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_generated_service_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_generated_service_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_generated_service_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
 var file_generated_service_proto_goTypes = []any{
-	(Theme)(0),                          // 0: service.Theme
-	(UserRole)(0),                       // 1: service.UserRole
-	(*ListOfListOfString)(nil),          // 2: service.ListOfListOfString
-	(*ListOfString)(nil),                // 3: service.ListOfString
-	(*LookupUserByIdRequestKey)(nil),    // 4: service.LookupUserByIdRequestKey
-	(*LookupUserByIdRequest)(nil),       // 5: service.LookupUserByIdRequest
-	(*LookupUserByIdResponse)(nil),      // 6: service.LookupUserByIdResponse
-	(*QueryUsersRequest)(nil),           // 7: service.QueryUsersRequest
-	(*QueryUsersResponse)(nil),          // 8: service.QueryUsersResponse
-	(*QueryUserRequest)(nil),            // 9: service.QueryUserRequest
-	(*QueryUserResponse)(nil),           // 10: service.QueryUserResponse
-	(*QueryExternalUsersRequest)(nil),   // 11: service.QueryExternalUsersRequest
-	(*QueryExternalUsersResponse)(nil),  // 12: service.QueryExternalUsersResponse
-	(*QueryExternalUserRequest)(nil),    // 13: service.QueryExternalUserRequest
-	(*QueryExternalUserResponse)(nil),   // 14: service.QueryExternalUserResponse
-	(*QueryUserActivityRequest)(nil),    // 15: service.QueryUserActivityRequest
-	(*QueryUserActivityResponse)(nil),   // 16: service.QueryUserActivityResponse
-	(*MutationUpdateUserRequest)(nil),   // 17: service.MutationUpdateUserRequest
-	(*MutationUpdateUserResponse)(nil),  // 18: service.MutationUpdateUserResponse
-	(*MutationUpdateUsersRequest)(nil),  // 19: service.MutationUpdateUsersRequest
-	(*MutationUpdateUsersResponse)(nil), // 20: service.MutationUpdateUsersResponse
-	(*MutationCreatePostRequest)(nil),   // 21: service.MutationCreatePostRequest
-	(*MutationCreatePostResponse)(nil),  // 22: service.MutationCreatePostResponse
-	(*User)(nil),                        // 23: service.User
-	(*ExternalUser)(nil),                // 24: service.ExternalUser
-	(*ActivityItem)(nil),                // 25: service.ActivityItem
-	(*UserInput)(nil),                   // 26: service.UserInput
-	(*PostInput)(nil),                   // 27: service.PostInput
-	(*Post)(nil),                        // 28: service.Post
-	(*Node)(nil),                        // 29: service.Node
-	(*Profile)(nil),                     // 30: service.Profile
-	(*Comment)(nil),                     // 31: service.Comment
-	(*Company)(nil),                     // 32: service.Company
-	(*Address)(nil),                     // 33: service.Address
-	(*Geo)(nil),                         // 34: service.Geo
-	(*ProfileInput)(nil),                // 35: service.ProfileInput
-	(*ListOfListOfString_List)(nil),     // 36: service.ListOfListOfString.List
-	(*ListOfString_List)(nil),           // 37: service.ListOfString.List
-	(*wrapperspb.Int32Value)(nil),       // 38: google.protobuf.Int32Value
-	(*wrapperspb.StringValue)(nil),      // 39: google.protobuf.StringValue
+	(NullableUserField)(0),                      // 0: service.NullableUserField
+	(UserOrderField)(0),                         // 1: service.UserOrderField
+	(OrderDirection)(0),                         // 2: service.OrderDirection
+	(SearchableType)(0),                         // 3: service.SearchableType
+	(Theme)(0),                                  // 4: service.Theme
+	(UserRole)(0),                               // 5: service.UserRole
+	(NullableProfileField)(0),                   // 6: service.NullableProfileField
+	(AuditAction)(0),                            // 7: service.AuditAction
+	(ActivityType)(0),                           // 8: service.ActivityType
+	(PostStatus)(0),                             // 9: service.PostStatus
+	(*ListOfActivityType)(nil),                  // 10: service.ListOfActivityType
+	(*ListOfListOfString)(nil),                  // 11: service.ListOfListOfString
+	(*ListOfNullableProfileField)(nil),          // 12: service.ListOfNullableProfileField
+	(*ListOfNullableUserField)(nil),             // 13: service.ListOfNullableUserField
+	(*ListOfPostStatus)(nil),                    // 14: service.ListOfPostStatus
+	(*ListOfSearchableType)(nil),                // 15: service.ListOfSearchableType
+	(*ListOfString)(nil),                        // 16: service.ListOfString
+	(*ListOfUserFilter)(nil),                    // 17: service.ListOfUserFilter
+	(*ListOfUserOrder)(nil),                     // 18: service.ListOfUserOrder
+	(*ListOfUserRole)(nil),                      // 19: service.ListOfUserRole
+	(*LookupUserByIdRequestKey)(nil),            // 20: service.LookupUserByIdRequestKey
+	(*LookupUserByIdRequest)(nil),               // 21: service.LookupUserByIdRequest
+	(*LookupUserByIdResponse)(nil),              // 22: service.LookupUserByIdResponse
+	(*LookupPostByIdRequestKey)(nil),            // 23: service.LookupPostByIdRequestKey
+	(*LookupPostByIdRequest)(nil),               // 24: service.LookupPostByIdRequest
+	(*LookupPostByIdResponse)(nil),              // 25: service.LookupPostByIdResponse
+	(*LookupCommentByIdRequestKey)(nil),         // 26: service.LookupCommentByIdRequestKey
+	(*LookupCommentByIdRequest)(nil),            // 27: service.LookupCommentByIdRequest
+	(*LookupCommentByIdResponse)(nil),           // 28: service.LookupCommentByIdResponse
+	(*QueryUsersRequest)(nil),                   // 29: service.QueryUsersRequest
+	(*QueryUsersResponse)(nil),                  // 30: service.QueryUsersResponse
+	(*QueryUserRequest)(nil),                    // 31: service.QueryUserRequest
+	(*QueryUserResponse)(nil),                   // 32: service.QueryUserResponse
+	(*QueryExternalUsersRequest)(nil),           // 33: service.QueryExternalUsersRequest
+	(*QueryExternalUsersResponse)(nil),          // 34: service.QueryExternalUsersResponse
+	(*QueryExternalUserRequest)(nil),            // 35: service.QueryExternalUserRequest
+	(*QueryExternalUserResponse)(nil),           // 36: service.QueryExternalUserResponse
+	(*QueryUserActivityRequest)(nil),            // 37: service.QueryUserActivityRequest
+	(*QueryUserActivityResponse)(nil),           // 38: service.QueryUserActivityResponse
+	(*QueryUsersConnectionRequest)(nil),         // 39: service.QueryUsersConnectionRequest
+	(*QueryUsersConnectionResponse)(nil),        // 40: service.QueryUsersConnectionResponse
+	(*QuerySearchRequest)(nil),                  // 41: service.QuerySearchRequest
+	(*QuerySearchResponse)(nil),                 // 42: service.QuerySearchResponse
+	(*QueryUserHistoryRequest)(nil),             // 43: service.QueryUserHistoryRequest
+	(*QueryUserHistoryResponse)(nil),            // 44: service.QueryUserHistoryResponse
+	(*QueryUserActivityConnectionRequest)(nil),  // 45: service.QueryUserActivityConnectionRequest
+	(*QueryUserActivityConnectionResponse)(nil), // 46: service.QueryUserActivityConnectionResponse
+	(*QueryPostRequest)(nil),                    // 47: service.QueryPostRequest
+	(*QueryPostResponse)(nil),                   // 48: service.QueryPostResponse
+	(*QueryPostsRequest)(nil),                   // 49: service.QueryPostsRequest
+	(*QueryPostsResponse)(nil),                  // 50: service.QueryPostsResponse
+	(*MutationUpdateUserRequest)(nil),           // 51: service.MutationUpdateUserRequest
+	(*MutationUpdateUserResponse)(nil),          // 52: service.MutationUpdateUserResponse
+	(*MutationUpdateUsersRequest)(nil),          // 53: service.MutationUpdateUsersRequest
+	(*MutationUpdateUsersResponse)(nil),         // 54: service.MutationUpdateUsersResponse
+	(*MutationCreatePostRequest)(nil),           // 55: service.MutationCreatePostRequest
+	(*MutationCreatePostResponse)(nil),          // 56: service.MutationCreatePostResponse
+	(*MutationCreateUserRequest)(nil),           // 57: service.MutationCreateUserRequest
+	(*MutationCreateUserResponse)(nil),          // 58: service.MutationCreateUserResponse
+	(*MutationDeleteUserRequest)(nil),           // 59: service.MutationDeleteUserRequest
+	(*MutationDeleteUserResponse)(nil),          // 60: service.MutationDeleteUserResponse
+	(*MutationRestoreUserRequest)(nil),          // 61: service.MutationRestoreUserRequest
+	(*MutationRestoreUserResponse)(nil),         // 62: service.MutationRestoreUserResponse
+	(*MutationCreateCommentRequest)(nil),        // 63: service.MutationCreateCommentRequest
+	(*MutationCreateCommentResponse)(nil),       // 64: service.MutationCreateCommentResponse
+	(*MutationUpdateCommentRequest)(nil),        // 65: service.MutationUpdateCommentRequest
+	(*MutationUpdateCommentResponse)(nil),       // 66: service.MutationUpdateCommentResponse
+	(*MutationDeleteCommentRequest)(nil),        // 67: service.MutationDeleteCommentRequest
+	(*MutationDeleteCommentResponse)(nil),       // 68: service.MutationDeleteCommentResponse
+	(*MutationUpdatePostRequest)(nil),           // 69: service.MutationUpdatePostRequest
+	(*MutationUpdatePostResponse)(nil),          // 70: service.MutationUpdatePostResponse
+	(*MutationDeletePostRequest)(nil),           // 71: service.MutationDeletePostRequest
+	(*MutationDeletePostResponse)(nil),          // 72: service.MutationDeletePostResponse
+	(*User)(nil),                                // 73: service.User
+	(*UserConnection)(nil),                      // 74: service.UserConnection
+	(*ExternalUser)(nil),                        // 75: service.ExternalUser
+	(*ActivityItem)(nil),                        // 76: service.ActivityItem
+	(*UserInput)(nil),                           // 77: service.UserInput
+	(*UserOrder)(nil),                           // 78: service.UserOrder
+	(*UserFilter)(nil),                          // 79: service.UserFilter
+	(*IntRange)(nil),                            // 80: service.IntRange
+	(*PostInput)(nil),                           // 81: service.PostInput
+	(*Post)(nil),                                // 82: service.Post
+	(*Node)(nil),                                // 83: service.Node
+	(*Profile)(nil),                             // 84: service.Profile
+	(*Comment)(nil),                             // 85: service.Comment
+	(*SearchResult)(nil),                        // 86: service.SearchResult
+	(*Company)(nil),                             // 87: service.Company
+	(*Address)(nil),                             // 88: service.Address
+	(*Geo)(nil),                                 // 89: service.Geo
+	(*CreateUserInput)(nil),                     // 90: service.CreateUserInput
+	(*ProfileInput)(nil),                        // 91: service.ProfileInput
+	(*UserEdge)(nil),                            // 92: service.UserEdge
+	(*PageInfo)(nil),                            // 93: service.PageInfo
+	(*UpdateUsersPayload)(nil),                  // 94: service.UpdateUsersPayload
+	(*UpdateUserResult)(nil),                    // 95: service.UpdateUserResult
+	(*UpdatedUser)(nil),                         // 96: service.UpdatedUser
+	(*UserNotFound)(nil),                        // 97: service.UserNotFound
+	(*ValidationFailed)(nil),                    // 98: service.ValidationFailed
+	(*VersionConflict)(nil),                     // 99: service.VersionConflict
+	(*AuditRecordConnection)(nil),               // 100: service.AuditRecordConnection
+	(*AuditRecordEdge)(nil),                     // 101: service.AuditRecordEdge
+	(*AuditRecord)(nil),                         // 102: service.AuditRecord
+	(*FieldChange)(nil),                         // 103: service.FieldChange
+	(*ActivityConnection)(nil),                  // 104: service.ActivityConnection
+	(*ActivityEdge)(nil),                        // 105: service.ActivityEdge
+	(*CommentInput)(nil),                        // 106: service.CommentInput
+	(*UpdateCommentInput)(nil),                  // 107: service.UpdateCommentInput
+	(*PostFilter)(nil),                          // 108: service.PostFilter
+	(*UpdatePostInput)(nil),                     // 109: service.UpdatePostInput
+	(*PostConnection)(nil),                      // 110: service.PostConnection
+	(*PostEdge)(nil),                            // 111: service.PostEdge
+	(*ListOfActivityType_List)(nil),             // 112: service.ListOfActivityType.List
+	(*ListOfListOfString_List)(nil),             // 113: service.ListOfListOfString.List
+	(*ListOfNullableProfileField_List)(nil),     // 114: service.ListOfNullableProfileField.List
+	(*ListOfNullableUserField_List)(nil),        // 115: service.ListOfNullableUserField.List
+	(*ListOfPostStatus_List)(nil),               // 116: service.ListOfPostStatus.List
+	(*ListOfSearchableType_List)(nil),           // 117: service.ListOfSearchableType.List
+	(*ListOfString_List)(nil),                   // 118: service.ListOfString.List
+	(*ListOfUserFilter_List)(nil),               // 119: service.ListOfUserFilter.List
+	(*ListOfUserOrder_List)(nil),                // 120: service.ListOfUserOrder.List
+	(*ListOfUserRole_List)(nil),                 // 121: service.ListOfUserRole.List
+	(*wrapperspb.BoolValue)(nil),                // 122: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),               // 123: google.protobuf.Int32Value
+	(*wrapperspb.StringValue)(nil),              // 124: google.protobuf.StringValue
 }
 var file_generated_service_proto_depIdxs = []int32{
-	36, // 0: service.ListOfListOfString.list:type_name -> service.ListOfListOfString.List
-	37, // 1: service.ListOfString.list:type_name -> service.ListOfString.List
-	4,  // 2: service.LookupUserByIdRequest.keys:type_name -> service.LookupUserByIdRequestKey
-	23, // 3: service.LookupUserByIdResponse.result:type_name -> service.User
-	23, // 4: service.QueryUsersResponse.users:type_name -> service.User
-	23, // 5: service.QueryUserResponse.user:type_name -> service.User
-	24, // 6: service.QueryExternalUsersResponse.external_users:type_name -> service.ExternalUser
-	24, // 7: service.QueryExternalUserResponse.external_user:type_name -> service.ExternalUser
-	38, // 8: service.QueryUserActivityRequest.limit:type_name -> google.protobuf.Int32Value
-	25, // 9: service.QueryUserActivityResponse.user_activity:type_name -> service.ActivityItem
-	26, // 10: service.MutationUpdateUserRequest.input:type_name -> service.UserInput
-	23, // 11: service.MutationUpdateUserResponse.update_user:type_name -> service.User
-	26, // 12: service.MutationUpdateUsersRequest.input:type_name -> service.UserInput
-	23, // 13: service.MutationUpdateUsersResponse.update_users:type_name -> service.User
-	27, // 14: service.MutationCreatePostRequest.input:type_name -> service.PostInput
-	28, // 15: service.MutationCreatePostResponse.create_post:type_name -> service.Post
-	1,  // 16: service.User.role:type_name -> service.UserRole
-	3,  // 17: service.User.tags:type_name -> service.ListOfString
-	2,  // 18: service.User.skill_categories:type_name -> service.ListOfListOfString
-	25, // 19: service.User.recent_activity:type_name -> service.ActivityItem
-	30, // 20: service.User.profile:type_name -> service.Profile
-	39, // 21: service.User.bio:type_name -> google.protobuf.StringValue
-	38, // 22: service.User.age:type_name -> google.protobuf.Int32Value
-	39, // 23: service.ExternalUser.phone:type_name -> google.protobuf.StringValue
-	39, // 24: service.ExternalUser.website:type_name -> google.protobuf.StringValue
-	32, // 25: service.ExternalUser.company:type_name -> service.Company
-	33, // 26: service.ExternalUser.address:type_name -> service.Address
-	28, // 27: service.ActivityItem.post:type_name -> service.Post
-	31, // 28: service.ActivityItem.comment:type_name -> service.Comment
-	39, // 29: service.UserInput.name:type_name -> google.protobuf.StringValue
-	39, // 30: service.UserInput.email:type_name -> google.protobuf.StringValue
-	1,  // 31: service.UserInput.role:type_name -> service.UserRole
-	3,  // 32: service.UserInput.permissions:type_name -> service.ListOfString
-	3,  // 33: service.UserInput.tags:type_name -> service.ListOfString
-	2,  // 34: service.UserInput.skill_categories:type_name -> service.ListOfListOfString
-	39, // 35: service.UserInput.bio:type_name -> google.protobuf.StringValue
-	38, // 36: service.UserInput.age:type_name -> google.protobuf.Int32Value
-	35, // 37: service.UserInput.profile:type_name -> service.ProfileInput
-	23, // 38: service.Node.user:type_name -> service.User
-	28, // 39: service.Node.post:type_name -> service.Post
-	31, // 40: service.Node.comment:type_name -> service.Comment
-	39, // 41: service.Profile.display_name:type_name -> google.protobuf.StringValue
-	39, // 42: service.Profile.timezone:type_name -> google.protobuf.StringValue
-	0,  // 43: service.Profile.theme:type_name -> service.Theme
-	39, // 44: service.Company.catch_phrase:type_name -> google.protobuf.StringValue
-	39, // 45: service.Company.bs:type_name -> google.protobuf.StringValue
-	39, // 46: service.Address.street:type_name -> google.protobuf.StringValue
-	39, // 47: service.Address.suite:type_name -> google.protobuf.StringValue
-	39, // 48: service.Address.city:type_name -> google.protobuf.StringValue
-	39, // 49: service.Address.zipcode:type_name -> google.protobuf.StringValue
-	34, // 50: service.Address.geo:type_name -> service.Geo
-	39, // 51: service.Address.test:type_name -> google.protobuf.StringValue
-	39, // 52: service.Geo.lat:type_name -> google.protobuf.StringValue
-	39, // 53: service.Geo.lng:type_name -> google.protobuf.StringValue
-	39, // 54: service.ProfileInput.display_name:type_name -> google.protobuf.StringValue
-	39, // 55: service.ProfileInput.timezone:type_name -> google.protobuf.StringValue
-	0,  // 56: service.ProfileInput.theme:type_name -> service.Theme
-	3,  // 57: service.ListOfListOfString.List.items:type_name -> service.ListOfString
-	5,  // 58: service.UsersService.LookupUserById:input_type -> service.LookupUserByIdRequest
-	21, // 59: service.UsersService.MutationCreatePost:input_type -> service.MutationCreatePostRequest
-	17, // 60: service.UsersService.MutationUpdateUser:input_type -> service.MutationUpdateUserRequest
-	19, // 61: service.UsersService.MutationUpdateUsers:input_type -> service.MutationUpdateUsersRequest
-	13, // 62: service.UsersService.QueryExternalUser:input_type -> service.QueryExternalUserRequest
-	11, // 63: service.UsersService.QueryExternalUsers:input_type -> service.QueryExternalUsersRequest
-	9,  // 64: service.UsersService.QueryUser:input_type -> service.QueryUserRequest
-	15, // 65: service.UsersService.QueryUserActivity:input_type -> service.QueryUserActivityRequest
-	7,  // 66: service.UsersService.QueryUsers:input_type -> service.QueryUsersRequest
-	6,  // 67: service.UsersService.LookupUserById:output_type -> service.LookupUserByIdResponse
-	22, // 68: service.UsersService.MutationCreatePost:output_type -> service.MutationCreatePostResponse
-	18, // 69: service.UsersService.MutationUpdateUser:output_type -> service.MutationUpdateUserResponse
-	20, // 70: service.UsersService.MutationUpdateUsers:output_type -> service.MutationUpdateUsersResponse
-	14, // 71: service.UsersService.QueryExternalUser:output_type -> service.QueryExternalUserResponse
-	12, // 72: service.UsersService.QueryExternalUsers:output_type -> service.QueryExternalUsersResponse
-	10, // 73: service.UsersService.QueryUser:output_type -> service.QueryUserResponse
-	16, // 74: service.UsersService.QueryUserActivity:output_type -> service.QueryUserActivityResponse
-	8,  // 75: service.UsersService.QueryUsers:output_type -> service.QueryUsersResponse
-	67, // [67:76] is the sub-list for method output_type
-	58, // [58:67] is the sub-list for method input_type
-	58, // [58:58] is the sub-list for extension type_name
-	58, // [58:58] is the sub-list for extension extendee
-	0,  // [0:58] is the sub-list for field type_name
+	112, // 0: service.ListOfActivityType.list:type_name -> service.ListOfActivityType.List
+	113, // 1: service.ListOfListOfString.list:type_name -> service.ListOfListOfString.List
+	114, // 2: service.ListOfNullableProfileField.list:type_name -> service.ListOfNullableProfileField.List
+	115, // 3: service.ListOfNullableUserField.list:type_name -> service.ListOfNullableUserField.List
+	116, // 4: service.ListOfPostStatus.list:type_name -> service.ListOfPostStatus.List
+	117, // 5: service.ListOfSearchableType.list:type_name -> service.ListOfSearchableType.List
+	118, // 6: service.ListOfString.list:type_name -> service.ListOfString.List
+	119, // 7: service.ListOfUserFilter.list:type_name -> service.ListOfUserFilter.List
+	120, // 8: service.ListOfUserOrder.list:type_name -> service.ListOfUserOrder.List
+	121, // 9: service.ListOfUserRole.list:type_name -> service.ListOfUserRole.List
+	20,  // 10: service.LookupUserByIdRequest.keys:type_name -> service.LookupUserByIdRequestKey
+	73,  // 11: service.LookupUserByIdResponse.result:type_name -> service.User
+	23,  // 12: service.LookupPostByIdRequest.keys:type_name -> service.LookupPostByIdRequestKey
+	82,  // 13: service.LookupPostByIdResponse.result:type_name -> service.Post
+	26,  // 14: service.LookupCommentByIdRequest.keys:type_name -> service.LookupCommentByIdRequestKey
+	85,  // 15: service.LookupCommentByIdResponse.result:type_name -> service.Comment
+	18,  // 16: service.QueryUsersRequest.order_by:type_name -> service.ListOfUserOrder
+	79,  // 17: service.QueryUsersRequest.filter:type_name -> service.UserFilter
+	122, // 18: service.QueryUsersRequest.include_deleted:type_name -> google.protobuf.BoolValue
+	73,  // 19: service.QueryUsersResponse.users:type_name -> service.User
+	122, // 20: service.QueryUserRequest.include_deleted:type_name -> google.protobuf.BoolValue
+	73,  // 21: service.QueryUserResponse.user:type_name -> service.User
+	75,  // 22: service.QueryExternalUsersResponse.external_users:type_name -> service.ExternalUser
+	75,  // 23: service.QueryExternalUserResponse.external_user:type_name -> service.ExternalUser
+	123, // 24: service.QueryUserActivityRequest.limit:type_name -> google.protobuf.Int32Value
+	122, // 25: service.QueryUserActivityRequest.include_deleted:type_name -> google.protobuf.BoolValue
+	124, // 26: service.QueryUserActivityRequest.since:type_name -> google.protobuf.StringValue
+	124, // 27: service.QueryUserActivityRequest.until:type_name -> google.protobuf.StringValue
+	76,  // 28: service.QueryUserActivityResponse.user_activity:type_name -> service.ActivityItem
+	123, // 29: service.QueryUsersConnectionRequest.first:type_name -> google.protobuf.Int32Value
+	124, // 30: service.QueryUsersConnectionRequest.after:type_name -> google.protobuf.StringValue
+	123, // 31: service.QueryUsersConnectionRequest.last:type_name -> google.protobuf.Int32Value
+	124, // 32: service.QueryUsersConnectionRequest.before:type_name -> google.protobuf.StringValue
+	18,  // 33: service.QueryUsersConnectionRequest.order_by:type_name -> service.ListOfUserOrder
+	79,  // 34: service.QueryUsersConnectionRequest.filter:type_name -> service.UserFilter
+	122, // 35: service.QueryUsersConnectionRequest.include_deleted:type_name -> google.protobuf.BoolValue
+	74,  // 36: service.QueryUsersConnectionResponse.users_connection:type_name -> service.UserConnection
+	15,  // 37: service.QuerySearchRequest.types:type_name -> service.ListOfSearchableType
+	123, // 38: service.QuerySearchRequest.first:type_name -> google.protobuf.Int32Value
+	86,  // 39: service.QuerySearchResponse.search:type_name -> service.SearchResult
+	123, // 40: service.QueryUserHistoryRequest.first:type_name -> google.protobuf.Int32Value
+	124, // 41: service.QueryUserHistoryRequest.after:type_name -> google.protobuf.StringValue
+	100, // 42: service.QueryUserHistoryResponse.user_history:type_name -> service.AuditRecordConnection
+	123, // 43: service.QueryUserActivityConnectionRequest.first:type_name -> google.protobuf.Int32Value
+	124, // 44: service.QueryUserActivityConnectionRequest.after:type_name -> google.protobuf.StringValue
+	10,  // 45: service.QueryUserActivityConnectionRequest.types:type_name -> service.ListOfActivityType
+	124, // 46: service.QueryUserActivityConnectionRequest.since:type_name -> google.protobuf.StringValue
+	124, // 47: service.QueryUserActivityConnectionRequest.until:type_name -> google.protobuf.StringValue
+	122, // 48: service.QueryUserActivityConnectionRequest.include_deleted:type_name -> google.protobuf.BoolValue
+	104, // 49: service.QueryUserActivityConnectionResponse.user_activity_connection:type_name -> service.ActivityConnection
+	82,  // 50: service.QueryPostResponse.post:type_name -> service.Post
+	108, // 51: service.QueryPostsRequest.filter:type_name -> service.PostFilter
+	123, // 52: service.QueryPostsRequest.first:type_name -> google.protobuf.Int32Value
+	124, // 53: service.QueryPostsRequest.after:type_name -> google.protobuf.StringValue
+	110, // 54: service.QueryPostsResponse.posts:type_name -> service.PostConnection
+	77,  // 55: service.MutationUpdateUserRequest.input:type_name -> service.UserInput
+	73,  // 56: service.MutationUpdateUserResponse.update_user:type_name -> service.User
+	77,  // 57: service.MutationUpdateUsersRequest.input:type_name -> service.UserInput
+	122, // 58: service.MutationUpdateUsersRequest.atomic:type_name -> google.protobuf.BoolValue
+	94,  // 59: service.MutationUpdateUsersResponse.update_users:type_name -> service.UpdateUsersPayload
+	81,  // 60: service.MutationCreatePostRequest.input:type_name -> service.PostInput
+	82,  // 61: service.MutationCreatePostResponse.create_post:type_name -> service.Post
+	90,  // 62: service.MutationCreateUserRequest.input:type_name -> service.CreateUserInput
+	73,  // 63: service.MutationCreateUserResponse.create_user:type_name -> service.User
+	73,  // 64: service.MutationDeleteUserResponse.delete_user:type_name -> service.User
+	73,  // 65: service.MutationRestoreUserResponse.restore_user:type_name -> service.User
+	106, // 66: service.MutationCreateCommentRequest.input:type_name -> service.CommentInput
+	85,  // 67: service.MutationCreateCommentResponse.create_comment:type_name -> service.Comment
+	107, // 68: service.MutationUpdateCommentRequest.input:type_name -> service.UpdateCommentInput
+	85,  // 69: service.MutationUpdateCommentResponse.update_comment:type_name -> service.Comment
+	85,  // 70: service.MutationDeleteCommentResponse.delete_comment:type_name -> service.Comment
+	109, // 71: service.MutationUpdatePostRequest.input:type_name -> service.UpdatePostInput
+	82,  // 72: service.MutationUpdatePostResponse.update_post:type_name -> service.Post
+	82,  // 73: service.MutationDeletePostResponse.delete_post:type_name -> service.Post
+	5,   // 74: service.User.role:type_name -> service.UserRole
+	16,  // 75: service.User.tags:type_name -> service.ListOfString
+	11,  // 76: service.User.skill_categories:type_name -> service.ListOfListOfString
+	76,  // 77: service.User.recent_activity:type_name -> service.ActivityItem
+	84,  // 78: service.User.profile:type_name -> service.Profile
+	124, // 79: service.User.bio:type_name -> google.protobuf.StringValue
+	123, // 80: service.User.age:type_name -> google.protobuf.Int32Value
+	124, // 81: service.User.deleted_at:type_name -> google.protobuf.StringValue
+	92,  // 82: service.UserConnection.edges:type_name -> service.UserEdge
+	93,  // 83: service.UserConnection.page_info:type_name -> service.PageInfo
+	124, // 84: service.ExternalUser.phone:type_name -> google.protobuf.StringValue
+	124, // 85: service.ExternalUser.website:type_name -> google.protobuf.StringValue
+	87,  // 86: service.ExternalUser.company:type_name -> service.Company
+	88,  // 87: service.ExternalUser.address:type_name -> service.Address
+	82,  // 88: service.ActivityItem.post:type_name -> service.Post
+	85,  // 89: service.ActivityItem.comment:type_name -> service.Comment
+	124, // 90: service.UserInput.name:type_name -> google.protobuf.StringValue
+	124, // 91: service.UserInput.email:type_name -> google.protobuf.StringValue
+	5,   // 92: service.UserInput.role:type_name -> service.UserRole
+	16,  // 93: service.UserInput.permissions:type_name -> service.ListOfString
+	16,  // 94: service.UserInput.tags:type_name -> service.ListOfString
+	11,  // 95: service.UserInput.skill_categories:type_name -> service.ListOfListOfString
+	124, // 96: service.UserInput.bio:type_name -> google.protobuf.StringValue
+	123, // 97: service.UserInput.age:type_name -> google.protobuf.Int32Value
+	91,  // 98: service.UserInput.profile:type_name -> service.ProfileInput
+	13,  // 99: service.UserInput.unset:type_name -> service.ListOfNullableUserField
+	123, // 100: service.UserInput.expected_version:type_name -> google.protobuf.Int32Value
+	1,   // 101: service.UserOrder.field:type_name -> service.UserOrderField
+	2,   // 102: service.UserOrder.direction:type_name -> service.OrderDirection
+	19,  // 103: service.UserFilter.role_in:type_name -> service.ListOfUserRole
+	16,  // 104: service.UserFilter.tags_contains_any:type_name -> service.ListOfString
+	16,  // 105: service.UserFilter.permissions_contains_all:type_name -> service.ListOfString
+	80,  // 106: service.UserFilter.age:type_name -> service.IntRange
+	124, // 107: service.UserFilter.skill:type_name -> google.protobuf.StringValue
+	17,  // 108: service.UserFilter.and:type_name -> service.ListOfUserFilter
+	17,  // 109: service.UserFilter.or:type_name -> service.ListOfUserFilter
+	79,  // 110: service.UserFilter.not:type_name -> service.UserFilter
+	123, // 111: service.IntRange.gt:type_name -> google.protobuf.Int32Value
+	123, // 112: service.IntRange.gte:type_name -> google.protobuf.Int32Value
+	123, // 113: service.IntRange.lt:type_name -> google.protobuf.Int32Value
+	123, // 114: service.IntRange.lte:type_name -> google.protobuf.Int32Value
+	9,   // 115: service.PostInput.status:type_name -> service.PostStatus
+	9,   // 116: service.Post.status:type_name -> service.PostStatus
+	85,  // 117: service.Post.comments:type_name -> service.Comment
+	124, // 118: service.Post.deleted_at:type_name -> google.protobuf.StringValue
+	73,  // 119: service.Node.user:type_name -> service.User
+	82,  // 120: service.Node.post:type_name -> service.Post
+	85,  // 121: service.Node.comment:type_name -> service.Comment
+	124, // 122: service.Profile.display_name:type_name -> google.protobuf.StringValue
+	124, // 123: service.Profile.timezone:type_name -> google.protobuf.StringValue
+	4,   // 124: service.Profile.theme:type_name -> service.Theme
+	124, // 125: service.Comment.deleted_at:type_name -> google.protobuf.StringValue
+	73,  // 126: service.SearchResult.user:type_name -> service.User
+	82,  // 127: service.SearchResult.post:type_name -> service.Post
+	85,  // 128: service.SearchResult.comment:type_name -> service.Comment
+	124, // 129: service.Company.catch_phrase:type_name -> google.protobuf.StringValue
+	124, // 130: service.Company.bs:type_name -> google.protobuf.StringValue
+	124, // 131: service.Address.street:type_name -> google.protobuf.StringValue
+	124, // 132: service.Address.suite:type_name -> google.protobuf.StringValue
+	124, // 133: service.Address.city:type_name -> google.protobuf.StringValue
+	124, // 134: service.Address.zipcode:type_name -> google.protobuf.StringValue
+	89,  // 135: service.Address.geo:type_name -> service.Geo
+	124, // 136: service.Address.test:type_name -> google.protobuf.StringValue
+	124, // 137: service.Geo.lat:type_name -> google.protobuf.StringValue
+	124, // 138: service.Geo.lng:type_name -> google.protobuf.StringValue
+	5,   // 139: service.CreateUserInput.role:type_name -> service.UserRole
+	16,  // 140: service.CreateUserInput.permissions:type_name -> service.ListOfString
+	16,  // 141: service.CreateUserInput.tags:type_name -> service.ListOfString
+	11,  // 142: service.CreateUserInput.skill_categories:type_name -> service.ListOfListOfString
+	124, // 143: service.CreateUserInput.bio:type_name -> google.protobuf.StringValue
+	123, // 144: service.CreateUserInput.age:type_name -> google.protobuf.Int32Value
+	91,  // 145: service.CreateUserInput.profile:type_name -> service.ProfileInput
+	124, // 146: service.ProfileInput.display_name:type_name -> google.protobuf.StringValue
+	124, // 147: service.ProfileInput.timezone:type_name -> google.protobuf.StringValue
+	4,   // 148: service.ProfileInput.theme:type_name -> service.Theme
+	12,  // 149: service.ProfileInput.unset:type_name -> service.ListOfNullableProfileField
+	73,  // 150: service.UserEdge.node:type_name -> service.User
+	124, // 151: service.PageInfo.start_cursor:type_name -> google.protobuf.StringValue
+	124, // 152: service.PageInfo.end_cursor:type_name -> google.protobuf.StringValue
+	95,  // 153: service.UpdateUsersPayload.results:type_name -> service.UpdateUserResult
+	96,  // 154: service.UpdateUserResult.updated_user:type_name -> service.UpdatedUser
+	97,  // 155: service.UpdateUserResult.user_not_found:type_name -> service.UserNotFound
+	98,  // 156: service.UpdateUserResult.validation_failed:type_name -> service.ValidationFailed
+	99,  // 157: service.UpdateUserResult.version_conflict:type_name -> service.VersionConflict
+	73,  // 158: service.UpdatedUser.user:type_name -> service.User
+	124, // 159: service.ValidationFailed.field:type_name -> google.protobuf.StringValue
+	101, // 160: service.AuditRecordConnection.edges:type_name -> service.AuditRecordEdge
+	93,  // 161: service.AuditRecordConnection.page_info:type_name -> service.PageInfo
+	102, // 162: service.AuditRecordEdge.node:type_name -> service.AuditRecord
+	7,   // 163: service.AuditRecord.action:type_name -> service.AuditAction
+	124, // 164: service.AuditRecord.actor:type_name -> google.protobuf.StringValue
+	103, // 165: service.AuditRecord.changes:type_name -> service.FieldChange
+	124, // 166: service.FieldChange.old_value:type_name -> google.protobuf.StringValue
+	124, // 167: service.FieldChange.new_value:type_name -> google.protobuf.StringValue
+	105, // 168: service.ActivityConnection.edges:type_name -> service.ActivityEdge
+	93,  // 169: service.ActivityConnection.page_info:type_name -> service.PageInfo
+	76,  // 170: service.ActivityEdge.node:type_name -> service.ActivityItem
+	124, // 171: service.PostFilter.author_id:type_name -> google.protobuf.StringValue
+	14,  // 172: service.PostFilter.status_in:type_name -> service.ListOfPostStatus
+	124, // 173: service.UpdatePostInput.title:type_name -> google.protobuf.StringValue
+	9,   // 174: service.UpdatePostInput.status:type_name -> service.PostStatus
+	111, // 175: service.PostConnection.edges:type_name -> service.PostEdge
+	93,  // 176: service.PostConnection.page_info:type_name -> service.PageInfo
+	82,  // 177: service.PostEdge.node:type_name -> service.Post
+	8,   // 178: service.ListOfActivityType.List.items:type_name -> service.ActivityType
+	16,  // 179: service.ListOfListOfString.List.items:type_name -> service.ListOfString
+	6,   // 180: service.ListOfNullableProfileField.List.items:type_name -> service.NullableProfileField
+	0,   // 181: service.ListOfNullableUserField.List.items:type_name -> service.NullableUserField
+	9,   // 182: service.ListOfPostStatus.List.items:type_name -> service.PostStatus
+	3,   // 183: service.ListOfSearchableType.List.items:type_name -> service.SearchableType
+	79,  // 184: service.ListOfUserFilter.List.items:type_name -> service.UserFilter
+	78,  // 185: service.ListOfUserOrder.List.items:type_name -> service.UserOrder
+	5,   // 186: service.ListOfUserRole.List.items:type_name -> service.UserRole
+	27,  // 187: service.UsersService.LookupCommentById:input_type -> service.LookupCommentByIdRequest
+	24,  // 188: service.UsersService.LookupPostById:input_type -> service.LookupPostByIdRequest
+	21,  // 189: service.UsersService.LookupUserById:input_type -> service.LookupUserByIdRequest
+	63,  // 190: service.UsersService.MutationCreateComment:input_type -> service.MutationCreateCommentRequest
+	55,  // 191: service.UsersService.MutationCreatePost:input_type -> service.MutationCreatePostRequest
+	57,  // 192: service.UsersService.MutationCreateUser:input_type -> service.MutationCreateUserRequest
+	67,  // 193: service.UsersService.MutationDeleteComment:input_type -> service.MutationDeleteCommentRequest
+	71,  // 194: service.UsersService.MutationDeletePost:input_type -> service.MutationDeletePostRequest
+	59,  // 195: service.UsersService.MutationDeleteUser:input_type -> service.MutationDeleteUserRequest
+	61,  // 196: service.UsersService.MutationRestoreUser:input_type -> service.MutationRestoreUserRequest
+	65,  // 197: service.UsersService.MutationUpdateComment:input_type -> service.MutationUpdateCommentRequest
+	69,  // 198: service.UsersService.MutationUpdatePost:input_type -> service.MutationUpdatePostRequest
+	51,  // 199: service.UsersService.MutationUpdateUser:input_type -> service.MutationUpdateUserRequest
+	53,  // 200: service.UsersService.MutationUpdateUsers:input_type -> service.MutationUpdateUsersRequest
+	35,  // 201: service.UsersService.QueryExternalUser:input_type -> service.QueryExternalUserRequest
+	33,  // 202: service.UsersService.QueryExternalUsers:input_type -> service.QueryExternalUsersRequest
+	47,  // 203: service.UsersService.QueryPost:input_type -> service.QueryPostRequest
+	49,  // 204: service.UsersService.QueryPosts:input_type -> service.QueryPostsRequest
+	31,  // 205: service.UsersService.QueryUser:input_type -> service.QueryUserRequest
+	41,  // 206: service.UsersService.QuerySearch:input_type -> service.QuerySearchRequest
+	37,  // 207: service.UsersService.QueryUserActivity:input_type -> service.QueryUserActivityRequest
+	45,  // 208: service.UsersService.QueryUserActivityConnection:input_type -> service.QueryUserActivityConnectionRequest
+	43,  // 209: service.UsersService.QueryUserHistory:input_type -> service.QueryUserHistoryRequest
+	29,  // 210: service.UsersService.QueryUsers:input_type -> service.QueryUsersRequest
+	39,  // 211: service.UsersService.QueryUsersConnection:input_type -> service.QueryUsersConnectionRequest
+	28,  // 212: service.UsersService.LookupCommentById:output_type -> service.LookupCommentByIdResponse
+	25,  // 213: service.UsersService.LookupPostById:output_type -> service.LookupPostByIdResponse
+	22,  // 214: service.UsersService.LookupUserById:output_type -> service.LookupUserByIdResponse
+	64,  // 215: service.UsersService.MutationCreateComment:output_type -> service.MutationCreateCommentResponse
+	56,  // 216: service.UsersService.MutationCreatePost:output_type -> service.MutationCreatePostResponse
+	58,  // 217: service.UsersService.MutationCreateUser:output_type -> service.MutationCreateUserResponse
+	68,  // 218: service.UsersService.MutationDeleteComment:output_type -> service.MutationDeleteCommentResponse
+	72,  // 219: service.UsersService.MutationDeletePost:output_type -> service.MutationDeletePostResponse
+	60,  // 220: service.UsersService.MutationDeleteUser:output_type -> service.MutationDeleteUserResponse
+	62,  // 221: service.UsersService.MutationRestoreUser:output_type -> service.MutationRestoreUserResponse
+	66,  // 222: service.UsersService.MutationUpdateComment:output_type -> service.MutationUpdateCommentResponse
+	70,  // 223: service.UsersService.MutationUpdatePost:output_type -> service.MutationUpdatePostResponse
+	52,  // 224: service.UsersService.MutationUpdateUser:output_type -> service.MutationUpdateUserResponse
+	54,  // 225: service.UsersService.MutationUpdateUsers:output_type -> service.MutationUpdateUsersResponse
+	36,  // 226: service.UsersService.QueryExternalUser:output_type -> service.QueryExternalUserResponse
+	34,  // 227: service.UsersService.QueryExternalUsers:output_type -> service.QueryExternalUsersResponse
+	48,  // 228: service.UsersService.QueryPost:output_type -> service.QueryPostResponse
+	50,  // 229: service.UsersService.QueryPosts:output_type -> service.QueryPostsResponse
+	32,  // 230: service.UsersService.QueryUser:output_type -> service.QueryUserResponse
+	42,  // 231: service.UsersService.QuerySearch:output_type -> service.QuerySearchResponse
+	38,  // 232: service.UsersService.QueryUserActivity:output_type -> service.QueryUserActivityResponse
+	46,  // 233: service.UsersService.QueryUserActivityConnection:output_type -> service.QueryUserActivityConnectionResponse
+	44,  // 234: service.UsersService.QueryUserHistory:output_type -> service.QueryUserHistoryResponse
+	30,  // 235: service.UsersService.QueryUsers:output_type -> service.QueryUsersResponse
+	40,  // 236: service.UsersService.QueryUsersConnection:output_type -> service.QueryUsersConnectionResponse
+	212, // [212:237] is the sub-list for method output_type
+	187, // [187:212] is the sub-list for method input_type
+	187, // [187:187] is the sub-list for extension type_name
+	187, // [187:187] is the sub-list for extension extendee
+	0,   // [0:187] is the sub-list for field type_name
 }
 
 func init() { file_generated_service_proto_init() }
@@ -2691,22 +8590,33 @@ func file_generated_service_proto_init() {
 	if File_generated_service_proto != nil {
 		return
 	}
-	file_generated_service_proto_msgTypes[23].OneofWrappers = []any{
+	file_generated_service_proto_msgTypes[66].OneofWrappers = []any{
 		(*ActivityItem_Post)(nil),
 		(*ActivityItem_Comment)(nil),
 	}
-	file_generated_service_proto_msgTypes[27].OneofWrappers = []any{
+	file_generated_service_proto_msgTypes[73].OneofWrappers = []any{
 		(*Node_User)(nil),
 		(*Node_Post)(nil),
 		(*Node_Comment)(nil),
 	}
+	file_generated_service_proto_msgTypes[76].OneofWrappers = []any{
+		(*SearchResult_User)(nil),
+		(*SearchResult_Post)(nil),
+		(*SearchResult_Comment)(nil),
+	}
+	file_generated_service_proto_msgTypes[85].OneofWrappers = []any{
+		(*UpdateUserResult_UpdatedUser)(nil),
+		(*UpdateUserResult_UserNotFound)(nil),
+		(*UpdateUserResult_ValidationFailed)(nil),
+		(*UpdateUserResult_VersionConflict)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_generated_service_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   36,
+			NumEnums:      10,
+			NumMessages:   112,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
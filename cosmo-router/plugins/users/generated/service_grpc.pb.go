@@ -19,15 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UsersService_LookupUserById_FullMethodName      = "/service.UsersService/LookupUserById"
-	UsersService_MutationCreatePost_FullMethodName  = "/service.UsersService/MutationCreatePost"
-	UsersService_MutationUpdateUser_FullMethodName  = "/service.UsersService/MutationUpdateUser"
-	UsersService_MutationUpdateUsers_FullMethodName = "/service.UsersService/MutationUpdateUsers"
-	UsersService_QueryExternalUser_FullMethodName   = "/service.UsersService/QueryExternalUser"
-	UsersService_QueryExternalUsers_FullMethodName  = "/service.UsersService/QueryExternalUsers"
-	UsersService_QueryUser_FullMethodName           = "/service.UsersService/QueryUser"
-	UsersService_QueryUserActivity_FullMethodName   = "/service.UsersService/QueryUserActivity"
-	UsersService_QueryUsers_FullMethodName          = "/service.UsersService/QueryUsers"
+	UsersService_LookupCommentById_FullMethodName           = "/service.UsersService/LookupCommentById"
+	UsersService_LookupPostById_FullMethodName              = "/service.UsersService/LookupPostById"
+	UsersService_LookupUserById_FullMethodName              = "/service.UsersService/LookupUserById"
+	UsersService_MutationCreateComment_FullMethodName       = "/service.UsersService/MutationCreateComment"
+	UsersService_MutationCreatePost_FullMethodName          = "/service.UsersService/MutationCreatePost"
+	UsersService_MutationCreateUser_FullMethodName          = "/service.UsersService/MutationCreateUser"
+	UsersService_MutationDeleteComment_FullMethodName       = "/service.UsersService/MutationDeleteComment"
+	UsersService_MutationDeletePost_FullMethodName          = "/service.UsersService/MutationDeletePost"
+	UsersService_MutationDeleteUser_FullMethodName          = "/service.UsersService/MutationDeleteUser"
+	UsersService_MutationRestoreUser_FullMethodName         = "/service.UsersService/MutationRestoreUser"
+	UsersService_MutationUpdateComment_FullMethodName       = "/service.UsersService/MutationUpdateComment"
+	UsersService_MutationUpdatePost_FullMethodName          = "/service.UsersService/MutationUpdatePost"
+	UsersService_MutationUpdateUser_FullMethodName          = "/service.UsersService/MutationUpdateUser"
+	UsersService_MutationUpdateUsers_FullMethodName         = "/service.UsersService/MutationUpdateUsers"
+	UsersService_QueryExternalUser_FullMethodName           = "/service.UsersService/QueryExternalUser"
+	UsersService_QueryExternalUsers_FullMethodName          = "/service.UsersService/QueryExternalUsers"
+	UsersService_QueryPost_FullMethodName                   = "/service.UsersService/QueryPost"
+	UsersService_QueryPosts_FullMethodName                  = "/service.UsersService/QueryPosts"
+	UsersService_QueryUser_FullMethodName                   = "/service.UsersService/QueryUser"
+	UsersService_QuerySearch_FullMethodName                 = "/service.UsersService/QuerySearch"
+	UsersService_QueryUserActivity_FullMethodName           = "/service.UsersService/QueryUserActivity"
+	UsersService_QueryUserActivityConnection_FullMethodName = "/service.UsersService/QueryUserActivityConnection"
+	UsersService_QueryUserHistory_FullMethodName            = "/service.UsersService/QueryUserHistory"
+	UsersService_QueryUsers_FullMethodName                  = "/service.UsersService/QueryUsers"
+	UsersService_QueryUsersConnection_FullMethodName        = "/service.UsersService/QueryUsersConnection"
 )
 
 // UsersServiceClient is the client API for UsersService service.
@@ -36,24 +52,56 @@ const (
 //
 // Service definition for UsersService
 type UsersServiceClient interface {
+	// Lookup Comment entity by id
+	LookupCommentById(ctx context.Context, in *LookupCommentByIdRequest, opts ...grpc.CallOption) (*LookupCommentByIdResponse, error)
+	// Lookup Post entity by id
+	LookupPostById(ctx context.Context, in *LookupPostByIdRequest, opts ...grpc.CallOption) (*LookupPostByIdResponse, error)
 	// Lookup User entity by id
 	LookupUserById(ctx context.Context, in *LookupUserByIdRequest, opts ...grpc.CallOption) (*LookupUserByIdResponse, error)
+	// Creates a comment on a post
+	MutationCreateComment(ctx context.Context, in *MutationCreateCommentRequest, opts ...grpc.CallOption) (*MutationCreateCommentResponse, error)
 	// Creates a new post
 	MutationCreatePost(ctx context.Context, in *MutationCreatePostRequest, opts ...grpc.CallOption) (*MutationCreatePostResponse, error)
+	// Creates a new user with a server-generated ID
+	MutationCreateUser(ctx context.Context, in *MutationCreateUserRequest, opts ...grpc.CallOption) (*MutationCreateUserResponse, error)
+	// Marks a comment as deleted and returns it
+	MutationDeleteComment(ctx context.Context, in *MutationDeleteCommentRequest, opts ...grpc.CallOption) (*MutationDeleteCommentResponse, error)
+	// Marks a post as deleted and returns it
+	MutationDeletePost(ctx context.Context, in *MutationDeletePostRequest, opts ...grpc.CallOption) (*MutationDeletePostResponse, error)
+	// Marks a user as deleted and returns it. Deleted users are hidden unless includeDeleted is set.
+	MutationDeleteUser(ctx context.Context, in *MutationDeleteUserRequest, opts ...grpc.CallOption) (*MutationDeleteUserResponse, error)
+	// Restores a deleted user together with the posts and comments deleted with it, and returns the user
+	MutationRestoreUser(ctx context.Context, in *MutationRestoreUserRequest, opts ...grpc.CallOption) (*MutationRestoreUserResponse, error)
+	// Changes the content of a comment and returns it
+	MutationUpdateComment(ctx context.Context, in *MutationUpdateCommentRequest, opts ...grpc.CallOption) (*MutationUpdateCommentResponse, error)
+	// Changes the title or status of a post and returns it
+	MutationUpdatePost(ctx context.Context, in *MutationUpdatePostRequest, opts ...grpc.CallOption) (*MutationUpdatePostResponse, error)
 	// Updates a single user's information
 	MutationUpdateUser(ctx context.Context, in *MutationUpdateUserRequest, opts ...grpc.CallOption) (*MutationUpdateUserResponse, error)
-	// Updates multiple users' information in a single operation
+	// Updates multiple users' information in a single operation. With atomic, either all users are updated or none.
 	MutationUpdateUsers(ctx context.Context, in *MutationUpdateUsersRequest, opts ...grpc.CallOption) (*MutationUpdateUsersResponse, error)
 	// Returns a single external user by ID
 	QueryExternalUser(ctx context.Context, in *QueryExternalUserRequest, opts ...grpc.CallOption) (*QueryExternalUserResponse, error)
 	// Returns a list of all external users
 	QueryExternalUsers(ctx context.Context, in *QueryExternalUsersRequest, opts ...grpc.CallOption) (*QueryExternalUsersResponse, error)
+	// Returns a single post by ID. Drafts and archived posts are only returned to their author.
+	QueryPost(ctx context.Context, in *QueryPostRequest, opts ...grpc.CallOption) (*QueryPostResponse, error)
+	// Returns a page of posts, most recently created first. Drafts and archived posts are only listed for their author.
+	QueryPosts(ctx context.Context, in *QueryPostsRequest, opts ...grpc.CallOption) (*QueryPostsResponse, error)
 	// Returns a single internal user by ID
 	QueryUser(ctx context.Context, in *QueryUserRequest, opts ...grpc.CallOption) (*QueryUserResponse, error)
-	// Returns recent activity items for a user
+	// Searches users, posts and comments, best matches first
+	QuerySearch(ctx context.Context, in *QuerySearchRequest, opts ...grpc.CallOption) (*QuerySearchResponse, error)
+	// Returns the activity items of a user, most recently created first. since and until are RFC 3339 times that limit the items to those created at or after since and before until
 	QueryUserActivity(ctx context.Context, in *QueryUserActivityRequest, opts ...grpc.CallOption) (*QueryUserActivityResponse, error)
+	// Returns a page of the activity items of a user, most recently created first. types limits the page to posts or comments, and since and until work like in userActivity.
+	QueryUserActivityConnection(ctx context.Context, in *QueryUserActivityConnectionRequest, opts ...grpc.CallOption) (*QueryUserActivityConnectionResponse, error)
+	// Returns the changes made to a user by mutations, most recent first
+	QueryUserHistory(ctx context.Context, in *QueryUserHistoryRequest, opts ...grpc.CallOption) (*QueryUserHistoryResponse, error)
 	// Returns a list of all internal users
 	QueryUsers(ctx context.Context, in *QueryUsersRequest, opts ...grpc.CallOption) (*QueryUsersResponse, error)
+	// Returns a page of internal users
+	QueryUsersConnection(ctx context.Context, in *QueryUsersConnectionRequest, opts ...grpc.CallOption) (*QueryUsersConnectionResponse, error)
 }
 
 type usersServiceClient struct {
@@ -64,6 +112,26 @@ func NewUsersServiceClient(cc grpc.ClientConnInterface) UsersServiceClient {
 	return &usersServiceClient{cc}
 }
 
+func (c *usersServiceClient) LookupCommentById(ctx context.Context, in *LookupCommentByIdRequest, opts ...grpc.CallOption) (*LookupCommentByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupCommentByIdResponse)
+	err := c.cc.Invoke(ctx, UsersService_LookupCommentById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) LookupPostById(ctx context.Context, in *LookupPostByIdRequest, opts ...grpc.CallOption) (*LookupPostByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupPostByIdResponse)
+	err := c.cc.Invoke(ctx, UsersService_LookupPostById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersServiceClient) LookupUserById(ctx context.Context, in *LookupUserByIdRequest, opts ...grpc.CallOption) (*LookupUserByIdResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LookupUserByIdResponse)
@@ -74,6 +142,16 @@ func (c *usersServiceClient) LookupUserById(ctx context.Context, in *LookupUserB
 	return out, nil
 }
 
+func (c *usersServiceClient) MutationCreateComment(ctx context.Context, in *MutationCreateCommentRequest, opts ...grpc.CallOption) (*MutationCreateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MutationCreateCommentResponse)
+	err := c.cc.Invoke(ctx, UsersService_MutationCreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersServiceClient) MutationCreatePost(ctx context.Context, in *MutationCreatePostRequest, opts ...grpc.CallOption) (*MutationCreatePostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MutationCreatePostResponse)
@@ -84,6 +162,76 @@ func (c *usersServiceClient) MutationCreatePost(ctx context.Context, in *Mutatio
 	return out, nil
 }
 
+func (c *usersServiceClient) MutationCreateUser(ctx context.Context, in *MutationCreateUserRequest, opts ...grpc.CallOption) (*MutationCreateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MutationCreateUserResponse)
+	err := c.cc.Invoke(ctx, UsersService_MutationCreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) MutationDeleteComment(ctx context.Context, in *MutationDeleteCommentRequest, opts ...grpc.CallOption) (*MutationDeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MutationDeleteCommentResponse)
+	err := c.cc.Invoke(ctx, UsersService_MutationDeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) MutationDeletePost(ctx context.Context, in *MutationDeletePostRequest, opts ...grpc.CallOption) (*MutationDeletePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MutationDeletePostResponse)
+	err := c.cc.Invoke(ctx, UsersService_MutationDeletePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) MutationDeleteUser(ctx context.Context, in *MutationDeleteUserRequest, opts ...grpc.CallOption) (*MutationDeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MutationDeleteUserResponse)
+	err := c.cc.Invoke(ctx, UsersService_MutationDeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) MutationRestoreUser(ctx context.Context, in *MutationRestoreUserRequest, opts ...grpc.CallOption) (*MutationRestoreUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MutationRestoreUserResponse)
+	err := c.cc.Invoke(ctx, UsersService_MutationRestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) MutationUpdateComment(ctx context.Context, in *MutationUpdateCommentRequest, opts ...grpc.CallOption) (*MutationUpdateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MutationUpdateCommentResponse)
+	err := c.cc.Invoke(ctx, UsersService_MutationUpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) MutationUpdatePost(ctx context.Context, in *MutationUpdatePostRequest, opts ...grpc.CallOption) (*MutationUpdatePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MutationUpdatePostResponse)
+	err := c.cc.Invoke(ctx, UsersService_MutationUpdatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersServiceClient) MutationUpdateUser(ctx context.Context, in *MutationUpdateUserRequest, opts ...grpc.CallOption) (*MutationUpdateUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MutationUpdateUserResponse)
@@ -124,6 +272,26 @@ func (c *usersServiceClient) QueryExternalUsers(ctx context.Context, in *QueryEx
 	return out, nil
 }
 
+func (c *usersServiceClient) QueryPost(ctx context.Context, in *QueryPostRequest, opts ...grpc.CallOption) (*QueryPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostResponse)
+	err := c.cc.Invoke(ctx, UsersService_QueryPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) QueryPosts(ctx context.Context, in *QueryPostsRequest, opts ...grpc.CallOption) (*QueryPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostsResponse)
+	err := c.cc.Invoke(ctx, UsersService_QueryPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersServiceClient) QueryUser(ctx context.Context, in *QueryUserRequest, opts ...grpc.CallOption) (*QueryUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryUserResponse)
@@ -134,6 +302,16 @@ func (c *usersServiceClient) QueryUser(ctx context.Context, in *QueryUserRequest
 	return out, nil
 }
 
+func (c *usersServiceClient) QuerySearch(ctx context.Context, in *QuerySearchRequest, opts ...grpc.CallOption) (*QuerySearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySearchResponse)
+	err := c.cc.Invoke(ctx, UsersService_QuerySearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersServiceClient) QueryUserActivity(ctx context.Context, in *QueryUserActivityRequest, opts ...grpc.CallOption) (*QueryUserActivityResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryUserActivityResponse)
@@ -144,6 +322,26 @@ func (c *usersServiceClient) QueryUserActivity(ctx context.Context, in *QueryUse
 	return out, nil
 }
 
+func (c *usersServiceClient) QueryUserActivityConnection(ctx context.Context, in *QueryUserActivityConnectionRequest, opts ...grpc.CallOption) (*QueryUserActivityConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUserActivityConnectionResponse)
+	err := c.cc.Invoke(ctx, UsersService_QueryUserActivityConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) QueryUserHistory(ctx context.Context, in *QueryUserHistoryRequest, opts ...grpc.CallOption) (*QueryUserHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUserHistoryResponse)
+	err := c.cc.Invoke(ctx, UsersService_QueryUserHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersServiceClient) QueryUsers(ctx context.Context, in *QueryUsersRequest, opts ...grpc.CallOption) (*QueryUsersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryUsersResponse)
@@ -154,30 +352,72 @@ func (c *usersServiceClient) QueryUsers(ctx context.Context, in *QueryUsersReque
 	return out, nil
 }
 
+func (c *usersServiceClient) QueryUsersConnection(ctx context.Context, in *QueryUsersConnectionRequest, opts ...grpc.CallOption) (*QueryUsersConnectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUsersConnectionResponse)
+	err := c.cc.Invoke(ctx, UsersService_QueryUsersConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersServiceServer is the server API for UsersService service.
 // All implementations must embed UnimplementedUsersServiceServer
 // for forward compatibility.
 //
 // Service definition for UsersService
 type UsersServiceServer interface {
+	// Lookup Comment entity by id
+	LookupCommentById(context.Context, *LookupCommentByIdRequest) (*LookupCommentByIdResponse, error)
+	// Lookup Post entity by id
+	LookupPostById(context.Context, *LookupPostByIdRequest) (*LookupPostByIdResponse, error)
 	// Lookup User entity by id
 	LookupUserById(context.Context, *LookupUserByIdRequest) (*LookupUserByIdResponse, error)
+	// Creates a comment on a post
+	MutationCreateComment(context.Context, *MutationCreateCommentRequest) (*MutationCreateCommentResponse, error)
 	// Creates a new post
 	MutationCreatePost(context.Context, *MutationCreatePostRequest) (*MutationCreatePostResponse, error)
+	// Creates a new user with a server-generated ID
+	MutationCreateUser(context.Context, *MutationCreateUserRequest) (*MutationCreateUserResponse, error)
+	// Marks a comment as deleted and returns it
+	MutationDeleteComment(context.Context, *MutationDeleteCommentRequest) (*MutationDeleteCommentResponse, error)
+	// Marks a post as deleted and returns it
+	MutationDeletePost(context.Context, *MutationDeletePostRequest) (*MutationDeletePostResponse, error)
+	// Marks a user as deleted and returns it. Deleted users are hidden unless includeDeleted is set.
+	MutationDeleteUser(context.Context, *MutationDeleteUserRequest) (*MutationDeleteUserResponse, error)
+	// Restores a deleted user together with the posts and comments deleted with it, and returns the user
+	MutationRestoreUser(context.Context, *MutationRestoreUserRequest) (*MutationRestoreUserResponse, error)
+	// Changes the content of a comment and returns it
+	MutationUpdateComment(context.Context, *MutationUpdateCommentRequest) (*MutationUpdateCommentResponse, error)
+	// Changes the title or status of a post and returns it
+	MutationUpdatePost(context.Context, *MutationUpdatePostRequest) (*MutationUpdatePostResponse, error)
 	// Updates a single user's information
 	MutationUpdateUser(context.Context, *MutationUpdateUserRequest) (*MutationUpdateUserResponse, error)
-	// Updates multiple users' information in a single operation
+	// Updates multiple users' information in a single operation. With atomic, either all users are updated or none.
 	MutationUpdateUsers(context.Context, *MutationUpdateUsersRequest) (*MutationUpdateUsersResponse, error)
 	// Returns a single external user by ID
 	QueryExternalUser(context.Context, *QueryExternalUserRequest) (*QueryExternalUserResponse, error)
 	// Returns a list of all external users
 	QueryExternalUsers(context.Context, *QueryExternalUsersRequest) (*QueryExternalUsersResponse, error)
+	// Returns a single post by ID. Drafts and archived posts are only returned to their author.
+	QueryPost(context.Context, *QueryPostRequest) (*QueryPostResponse, error)
+	// Returns a page of posts, most recently created first. Drafts and archived posts are only listed for their author.
+	QueryPosts(context.Context, *QueryPostsRequest) (*QueryPostsResponse, error)
 	// Returns a single internal user by ID
 	QueryUser(context.Context, *QueryUserRequest) (*QueryUserResponse, error)
-	// Returns recent activity items for a user
+	// Searches users, posts and comments, best matches first
+	QuerySearch(context.Context, *QuerySearchRequest) (*QuerySearchResponse, error)
+	// Returns the activity items of a user, most recently created first. since and until are RFC 3339 times that limit the items to those created at or after since and before until
 	QueryUserActivity(context.Context, *QueryUserActivityRequest) (*QueryUserActivityResponse, error)
+	// Returns a page of the activity items of a user, most recently created first. types limits the page to posts or comments, and since and until work like in userActivity.
+	QueryUserActivityConnection(context.Context, *QueryUserActivityConnectionRequest) (*QueryUserActivityConnectionResponse, error)
+	// Returns the changes made to a user by mutations, most recent first
+	QueryUserHistory(context.Context, *QueryUserHistoryRequest) (*QueryUserHistoryResponse, error)
 	// Returns a list of all internal users
 	QueryUsers(context.Context, *QueryUsersRequest) (*QueryUsersResponse, error)
+	// Returns a page of internal users
+	QueryUsersConnection(context.Context, *QueryUsersConnectionRequest) (*QueryUsersConnectionResponse, error)
 	mustEmbedUnimplementedUsersServiceServer()
 }
 
@@ -188,12 +428,42 @@ type UsersServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedUsersServiceServer struct{}
 
+func (UnimplementedUsersServiceServer) LookupCommentById(context.Context, *LookupCommentByIdRequest) (*LookupCommentByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupCommentById not implemented")
+}
+func (UnimplementedUsersServiceServer) LookupPostById(context.Context, *LookupPostByIdRequest) (*LookupPostByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupPostById not implemented")
+}
 func (UnimplementedUsersServiceServer) LookupUserById(context.Context, *LookupUserByIdRequest) (*LookupUserByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupUserById not implemented")
 }
+func (UnimplementedUsersServiceServer) MutationCreateComment(context.Context, *MutationCreateCommentRequest) (*MutationCreateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MutationCreateComment not implemented")
+}
 func (UnimplementedUsersServiceServer) MutationCreatePost(context.Context, *MutationCreatePostRequest) (*MutationCreatePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MutationCreatePost not implemented")
 }
+func (UnimplementedUsersServiceServer) MutationCreateUser(context.Context, *MutationCreateUserRequest) (*MutationCreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MutationCreateUser not implemented")
+}
+func (UnimplementedUsersServiceServer) MutationDeleteComment(context.Context, *MutationDeleteCommentRequest) (*MutationDeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MutationDeleteComment not implemented")
+}
+func (UnimplementedUsersServiceServer) MutationDeletePost(context.Context, *MutationDeletePostRequest) (*MutationDeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MutationDeletePost not implemented")
+}
+func (UnimplementedUsersServiceServer) MutationDeleteUser(context.Context, *MutationDeleteUserRequest) (*MutationDeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MutationDeleteUser not implemented")
+}
+func (UnimplementedUsersServiceServer) MutationRestoreUser(context.Context, *MutationRestoreUserRequest) (*MutationRestoreUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MutationRestoreUser not implemented")
+}
+func (UnimplementedUsersServiceServer) MutationUpdateComment(context.Context, *MutationUpdateCommentRequest) (*MutationUpdateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MutationUpdateComment not implemented")
+}
+func (UnimplementedUsersServiceServer) MutationUpdatePost(context.Context, *MutationUpdatePostRequest) (*MutationUpdatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MutationUpdatePost not implemented")
+}
 func (UnimplementedUsersServiceServer) MutationUpdateUser(context.Context, *MutationUpdateUserRequest) (*MutationUpdateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MutationUpdateUser not implemented")
 }
@@ -206,15 +476,33 @@ func (UnimplementedUsersServiceServer) QueryExternalUser(context.Context, *Query
 func (UnimplementedUsersServiceServer) QueryExternalUsers(context.Context, *QueryExternalUsersRequest) (*QueryExternalUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryExternalUsers not implemented")
 }
+func (UnimplementedUsersServiceServer) QueryPost(context.Context, *QueryPostRequest) (*QueryPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPost not implemented")
+}
+func (UnimplementedUsersServiceServer) QueryPosts(context.Context, *QueryPostsRequest) (*QueryPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPosts not implemented")
+}
 func (UnimplementedUsersServiceServer) QueryUser(context.Context, *QueryUserRequest) (*QueryUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryUser not implemented")
 }
+func (UnimplementedUsersServiceServer) QuerySearch(context.Context, *QuerySearchRequest) (*QuerySearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySearch not implemented")
+}
 func (UnimplementedUsersServiceServer) QueryUserActivity(context.Context, *QueryUserActivityRequest) (*QueryUserActivityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryUserActivity not implemented")
 }
+func (UnimplementedUsersServiceServer) QueryUserActivityConnection(context.Context, *QueryUserActivityConnectionRequest) (*QueryUserActivityConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUserActivityConnection not implemented")
+}
+func (UnimplementedUsersServiceServer) QueryUserHistory(context.Context, *QueryUserHistoryRequest) (*QueryUserHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUserHistory not implemented")
+}
 func (UnimplementedUsersServiceServer) QueryUsers(context.Context, *QueryUsersRequest) (*QueryUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryUsers not implemented")
 }
+func (UnimplementedUsersServiceServer) QueryUsersConnection(context.Context, *QueryUsersConnectionRequest) (*QueryUsersConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUsersConnection not implemented")
+}
 func (UnimplementedUsersServiceServer) mustEmbedUnimplementedUsersServiceServer() {}
 func (UnimplementedUsersServiceServer) testEmbeddedByValue()                      {}
 
@@ -236,6 +524,42 @@ func RegisterUsersServiceServer(s grpc.ServiceRegistrar, srv UsersServiceServer)
 	s.RegisterService(&UsersService_ServiceDesc, srv)
 }
 
+func _UsersService_LookupCommentById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupCommentByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).LookupCommentById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_LookupCommentById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).LookupCommentById(ctx, req.(*LookupCommentByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_LookupPostById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupPostByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).LookupPostById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_LookupPostById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).LookupPostById(ctx, req.(*LookupPostByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UsersService_LookupUserById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LookupUserByIdRequest)
 	if err := dec(in); err != nil {
@@ -254,6 +578,24 @@ func _UsersService_LookupUserById_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_MutationCreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutationCreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).MutationCreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_MutationCreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).MutationCreateComment(ctx, req.(*MutationCreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UsersService_MutationCreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MutationCreatePostRequest)
 	if err := dec(in); err != nil {
@@ -272,6 +614,132 @@ func _UsersService_MutationCreatePost_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_MutationCreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutationCreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).MutationCreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_MutationCreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).MutationCreateUser(ctx, req.(*MutationCreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_MutationDeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutationDeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).MutationDeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_MutationDeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).MutationDeleteComment(ctx, req.(*MutationDeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_MutationDeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutationDeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).MutationDeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_MutationDeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).MutationDeletePost(ctx, req.(*MutationDeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_MutationDeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutationDeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).MutationDeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_MutationDeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).MutationDeleteUser(ctx, req.(*MutationDeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_MutationRestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutationRestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).MutationRestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_MutationRestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).MutationRestoreUser(ctx, req.(*MutationRestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_MutationUpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutationUpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).MutationUpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_MutationUpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).MutationUpdateComment(ctx, req.(*MutationUpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_MutationUpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutationUpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).MutationUpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_MutationUpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).MutationUpdatePost(ctx, req.(*MutationUpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UsersService_MutationUpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MutationUpdateUserRequest)
 	if err := dec(in); err != nil {
@@ -344,6 +812,42 @@ func _UsersService_QueryExternalUsers_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_QueryPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).QueryPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_QueryPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).QueryPost(ctx, req.(*QueryPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_QueryPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).QueryPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_QueryPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).QueryPosts(ctx, req.(*QueryPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UsersService_QueryUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryUserRequest)
 	if err := dec(in); err != nil {
@@ -362,6 +866,24 @@ func _UsersService_QueryUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_QuerySearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).QuerySearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_QuerySearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).QuerySearch(ctx, req.(*QuerySearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UsersService_QueryUserActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryUserActivityRequest)
 	if err := dec(in); err != nil {
@@ -380,6 +902,42 @@ func _UsersService_QueryUserActivity_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_QueryUserActivityConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserActivityConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).QueryUserActivityConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_QueryUserActivityConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).QueryUserActivityConnection(ctx, req.(*QueryUserActivityConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_QueryUserHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).QueryUserHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_QueryUserHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).QueryUserHistory(ctx, req.(*QueryUserHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UsersService_QueryUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryUsersRequest)
 	if err := dec(in); err != nil {
@@ -398,6 +956,24 @@ func _UsersService_QueryUsers_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_QueryUsersConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUsersConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).QueryUsersConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_QueryUsersConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).QueryUsersConnection(ctx, req.(*QueryUsersConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UsersService_ServiceDesc is the grpc.ServiceDesc for UsersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -405,14 +981,54 @@ var UsersService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "service.UsersService",
 	HandlerType: (*UsersServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LookupCommentById",
+			Handler:    _UsersService_LookupCommentById_Handler,
+		},
+		{
+			MethodName: "LookupPostById",
+			Handler:    _UsersService_LookupPostById_Handler,
+		},
 		{
 			MethodName: "LookupUserById",
 			Handler:    _UsersService_LookupUserById_Handler,
 		},
+		{
+			MethodName: "MutationCreateComment",
+			Handler:    _UsersService_MutationCreateComment_Handler,
+		},
 		{
 			MethodName: "MutationCreatePost",
 			Handler:    _UsersService_MutationCreatePost_Handler,
 		},
+		{
+			MethodName: "MutationCreateUser",
+			Handler:    _UsersService_MutationCreateUser_Handler,
+		},
+		{
+			MethodName: "MutationDeleteComment",
+			Handler:    _UsersService_MutationDeleteComment_Handler,
+		},
+		{
+			MethodName: "MutationDeletePost",
+			Handler:    _UsersService_MutationDeletePost_Handler,
+		},
+		{
+			MethodName: "MutationDeleteUser",
+			Handler:    _UsersService_MutationDeleteUser_Handler,
+		},
+		{
+			MethodName: "MutationRestoreUser",
+			Handler:    _UsersService_MutationRestoreUser_Handler,
+		},
+		{
+			MethodName: "MutationUpdateComment",
+			Handler:    _UsersService_MutationUpdateComment_Handler,
+		},
+		{
+			MethodName: "MutationUpdatePost",
+			Handler:    _UsersService_MutationUpdatePost_Handler,
+		},
 		{
 			MethodName: "MutationUpdateUser",
 			Handler:    _UsersService_MutationUpdateUser_Handler,
@@ -429,18 +1045,42 @@ var UsersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryExternalUsers",
 			Handler:    _UsersService_QueryExternalUsers_Handler,
 		},
+		{
+			MethodName: "QueryPost",
+			Handler:    _UsersService_QueryPost_Handler,
+		},
+		{
+			MethodName: "QueryPosts",
+			Handler:    _UsersService_QueryPosts_Handler,
+		},
 		{
 			MethodName: "QueryUser",
 			Handler:    _UsersService_QueryUser_Handler,
 		},
+		{
+			MethodName: "QuerySearch",
+			Handler:    _UsersService_QuerySearch_Handler,
+		},
 		{
 			MethodName: "QueryUserActivity",
 			Handler:    _UsersService_QueryUserActivity_Handler,
 		},
+		{
+			MethodName: "QueryUserActivityConnection",
+			Handler:    _UsersService_QueryUserActivityConnection_Handler,
+		},
+		{
+			MethodName: "QueryUserHistory",
+			Handler:    _UsersService_QueryUserHistory_Handler,
+		},
 		{
 			MethodName: "QueryUsers",
 			Handler:    _UsersService_QueryUsers_Handler,
 		},
+		{
+			MethodName: "QueryUsersConnection",
+			Handler:    _UsersService_QueryUsersConnection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "generated/service.proto",
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Kinds of records referenced by the activity index
+const (
+	activityKindPost    = "post"
+	activityKindComment = "comment"
+)
+
+// activityRef points from a user's activity index to a post or comment.
+// Stores keep one list of refs per author, most recently indexed first, and
+// resolve the refs to ActivityItems on read, ordered by compareActivity. Posts
+// and comments are the single source of truth for activity, so
+// User.recentActivity and userActivity always agree.
+type activityRef struct {
+	Kind string
+	ID   string
+}
+
+// prependActivity moves ref to the front of refs, adding it if it isn't present yet
+func prependActivity(refs []activityRef, ref activityRef) []activityRef {
+	refs = removeActivity(refs, ref)
+	return append([]activityRef{ref}, refs...)
+}
+
+// removeActivity returns refs without ref
+func removeActivity(refs []activityRef, ref activityRef) []activityRef {
+	result := make([]activityRef, 0, len(refs))
+	for _, r := range refs {
+		if r != ref {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// moveRef moves ref from the refs of key from to the front of the refs of key
+// to, adding it if it isn't present yet. Refs that keep their key also keep
+// their position. Stores use it for the activity index, keyed by author, and
+// for the comments of posts, keyed by post.
+func moveRef(index map[string][]activityRef, ref activityRef, from, to string) {
+	if from == to {
+		return
+	}
+	if from != "" {
+		index[from] = removeActivity(index[from], ref)
+	}
+	index[to] = prependActivity(index[to], ref)
+}
+
+// encodeActivityRefs serializes refs for stores that persist the index
+func encodeActivityRefs(refs []activityRef) []byte {
+	lines := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		lines = append(lines, ref.Kind+":"+ref.ID)
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// decodeActivityRefs parses refs serialized by encodeActivityRefs
+func decodeActivityRefs(data []byte) ([]activityRef, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	lines := strings.Split(string(data), "\n")
+	refs := make([]activityRef, 0, len(lines))
+	for _, line := range lines {
+		kind, id, ok := strings.Cut(line, ":")
+		if !ok || (kind != activityKindPost && kind != activityKindComment) {
+			return nil, fmt.Errorf("%w: invalid activity entry %q", ErrCorrupt, line)
+		}
+		refs = append(refs, activityRef{Kind: kind, ID: id})
+	}
+	return refs, nil
+}
+
+// activityCreatedAt returns the creation time of the post or comment of an activity item
+func activityCreatedAt(item *service.ActivityItem) string {
+	switch value := item.Value.(type) {
+	case *service.ActivityItem_Post:
+		return value.Post.CreatedAt
+	case *service.ActivityItem_Comment:
+		return value.Comment.CreatedAt
+	}
+	return ""
+}
+
+// itemRef returns the ref of the post or comment of an activity item
+func itemRef(item *service.ActivityItem) activityRef {
+	switch value := item.Value.(type) {
+	case *service.ActivityItem_Post:
+		return activityRef{Kind: activityKindPost, ID: value.Post.Id}
+	case *service.ActivityItem_Comment:
+		return activityRef{Kind: activityKindComment, ID: value.Comment.Id}
+	}
+	return activityRef{}
+}
+
+// compareActivity orders activity items most recently created first. Creation
+// times are formatted by formatTimestamp, so they compare as strings. Items
+// created at the same time are ordered by kind and then by descending ID, so
+// the order is total and cursors of activity connections are stable.
+func compareActivity(a, b *service.ActivityItem) int {
+	if c := strings.Compare(activityCreatedAt(b), activityCreatedAt(a)); c != 0 {
+		return c
+	}
+	refA, refB := itemRef(a), itemRef(b)
+	if refA.Kind != refB.Kind {
+		return strings.Compare(refA.Kind, refB.Kind)
+	}
+	return compareIDs(refB.ID, refA.ID)
+}
+
+// compareComments orders the comments of a post oldest first, and comments
+// created at the same time by ID
+func compareComments(a, b *service.Comment) int {
+	if c := strings.Compare(a.CreatedAt, b.CreatedAt); c != 0 {
+		return c
+	}
+	return compareIDs(a.Id, b.Id)
+}
+
+// comparePosts orders posts most recently created first, and posts created at
+// the same time by descending ID
+func comparePosts(a, b *service.Post) int {
+	if c := strings.Compare(b.CreatedAt, a.CreatedAt); c != 0 {
+		return c
+	}
+	return compareIDs(b.Id, a.Id)
+}
+
+// sortActivity orders activity items by compareActivity
+func sortActivity(items []*service.ActivityItem) {
+	slices.SortFunc(items, compareActivity)
+}
+
+// activityType returns the ActivityType of an activity item
+func activityType(item *service.ActivityItem) service.ActivityType {
+	switch item.Value.(type) {
+	case *service.ActivityItem_Post:
+		return service.ActivityType_ACTIVITY_TYPE_POST
+	case *service.ActivityItem_Comment:
+		return service.ActivityType_ACTIVITY_TYPE_COMMENT
+	}
+	return service.ActivityType_ACTIVITY_TYPE_UNSPECIFIED
+}
+
+// filterActivityTypes removes the items whose type isn't listed in types.
+// A missing list keeps every item, while an empty one keeps none.
+func filterActivityTypes(items []*service.ActivityItem, types *service.ListOfActivityType) []*service.ActivityItem {
+	if types == nil {
+		return items
+	}
+	return slices.DeleteFunc(items, func(item *service.ActivityItem) bool {
+		return !slices.Contains(types.GetList().GetItems(), activityType(item))
+	})
+}
+
+// activityCursorCodec is the codec for cursors of an activity connection.
+// A cursor stores the post or comment reduced to its ID and creation time.
+var activityCursorCodec = cursorCodec[*service.ActivityItem]{
+	kind: "activity",
+	encode: func(item *service.ActivityItem) string {
+		key := &service.ActivityItem{}
+		switch value := item.Value.(type) {
+		case *service.ActivityItem_Post:
+			key.Value = &service.ActivityItem_Post{Post: &service.Post{Id: value.Post.Id, CreatedAt: value.Post.CreatedAt}}
+		case *service.ActivityItem_Comment:
+			key.Value = &service.ActivityItem_Comment{Comment: &service.Comment{Id: value.Comment.Id, CreatedAt: value.Comment.CreatedAt}}
+		}
+		return marshalCursorKey(key)
+	},
+	decode: func(key string) (*service.ActivityItem, error) {
+		item := &service.ActivityItem{}
+		if err := proto.Unmarshal([]byte(key), item); err != nil {
+			return nil, err
+		}
+		if item.Value == nil {
+			return nil, fmt.Errorf("cursor doesn't point at a post or comment")
+		}
+		return item, nil
+	},
+}
+
+// postCursorCodec is the codec for cursors of a post connection.
+// A cursor stores the post reduced to its ID and creation time.
+var postCursorCodec = cursorCodec[*service.Post]{
+	kind: "post",
+	encode: func(post *service.Post) string {
+		key := &service.Post{Id: post.Id, CreatedAt: post.CreatedAt}
+		return marshalCursorKey(key)
+	},
+	decode: func(key string) (*service.Post, error) {
+		post := &service.Post{}
+		if err := proto.Unmarshal([]byte(key), post); err != nil {
+			return nil, err
+		}
+		if post.Id == "" {
+			return nil, fmt.Errorf("cursor doesn't point at a post")
+		}
+		return post, nil
+	},
+}
+
+// timeRange is the half-open range [since, until) of creation times selected
+// by the since and until arguments of userActivity. Zero bounds are open.
+type timeRange struct {
+	since time.Time
+	until time.Time
+}
+
+// parseTimeRange validates the RFC 3339 since and until arguments.
+// Invalid arguments are reported as a *fieldError.
+func parseTimeRange(since, until *wrapperspb.StringValue) (timeRange, error) {
+	var r timeRange
+	var err error
+	if since != nil {
+		if r.since, err = time.Parse(time.RFC3339, since.Value); err != nil {
+			return r, &fieldError{Field: "since", Message: fmt.Sprintf("must be an RFC 3339 time like 2024-05-01T12:00:00Z, got %q", since.Value)}
+		}
+	}
+	if until != nil {
+		if r.until, err = time.Parse(time.RFC3339, until.Value); err != nil {
+			return r, &fieldError{Field: "until", Message: fmt.Sprintf("must be an RFC 3339 time like 2024-05-01T12:00:00Z, got %q", until.Value)}
+		}
+	}
+	if since != nil && until != nil && !r.until.After(r.since) {
+		return r, &fieldError{Field: "until", Message: "must be after since"}
+	}
+	return r, nil
+}
+
+// contains reports whether the item was created within the range. Items
+// without a valid creation time are only contained in unbounded ranges.
+func (r timeRange) contains(item *service.ActivityItem) bool {
+	if r.since.IsZero() && r.until.IsZero() {
+		return true
+	}
+	createdAt, err := time.Parse(time.RFC3339, activityCreatedAt(item))
+	if err != nil {
+		return false
+	}
+	if !r.since.IsZero() && createdAt.Before(r.since) {
+		return false
+	}
+	return r.until.IsZero() || createdAt.Before(r.until)
+}
+
+// filter removes the items that weren't created within the range
+func (r timeRange) filter(items []*service.ActivityItem) []*service.ActivityItem {
+	return slices.DeleteFunc(items, func(item *service.ActivityItem) bool { return !r.contains(item) })
+}
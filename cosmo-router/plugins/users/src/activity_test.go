@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestActivityIndex(t *testing.T) {
+	for name, config := range testStoreConfigs() {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			stores, err := openStore(config(t))
+			require.NoError(t, err)
+			defer stores.close()
+			seedTestStores(t, stores)
+
+			// A new post becomes the most recent activity of its author
+			post := &service.Post{Id: "5", Title: "New Post", AuthorId: "2"}
+			require.NoError(t, stores.posts.SavePost(ctx, post))
+			assert.NotEmpty(t, post.CreatedAt, "the store sets the creation time")
+			assert.Equal(t, post.CreatedAt, post.UpdatedAt)
+			activity, err := stores.users.ListUserActivity(ctx, "2")
+			require.NoError(t, err)
+			require.NotEmpty(t, activity)
+			assert.Equal(t, "5", activity[0].GetPost().GetId())
+			assert.Equal(t, post.CreatedAt, activity[0].GetPost().GetCreatedAt())
+
+			// Editing a post keeps its creation time and position in the activity
+			renamed := &service.Post{Id: "3", Title: "Renamed", AuthorId: "2", CreatedAt: "2030-01-01T00:00:00.000Z"}
+			require.NoError(t, stores.posts.SavePost(ctx, renamed))
+			assert.Equal(t, "2024-02-20T10:15:00.000Z", renamed.CreatedAt)
+			assert.Greater(t, renamed.UpdatedAt, renamed.CreatedAt)
+			activity, err = stores.users.ListUserActivity(ctx, "2")
+			require.NoError(t, err)
+			assert.Equal(t, "5", activity[0].GetPost().GetId())
+			assert.Equal(t, "Renamed", activity[len(activity)-1].GetPost().GetTitle())
+
+			// Changing the author of a comment moves it to the new author's
+			// activity, ordered by its creation time
+			require.NoError(t, stores.comments.SaveComment(ctx, &service.Comment{Id: "1", Content: "Moved", AuthorId: "3", PostId: "1"}))
+			bobActivity, err := stores.users.ListUserActivity(ctx, "2")
+			require.NoError(t, err)
+			for _, item := range bobActivity {
+				assert.NotEqual(t, "1", item.GetComment().GetId())
+			}
+			charlieActivity, err := stores.users.ListUserActivity(ctx, "3")
+			require.NoError(t, err)
+			require.Len(t, charlieActivity, 3)
+			assert.Equal(t, "2", charlieActivity[0].GetComment().GetId())
+			assert.Equal(t, "Moved", charlieActivity[1].GetComment().GetContent())
+
+			// Deleted posts and comments disappear from the activity
+			require.NoError(t, stores.posts.DeletePost(ctx, "5", time.Now()))
+			require.NoError(t, stores.comments.DeleteComment(ctx, "1", time.Now()))
+			activity, err = stores.users.ListUserActivity(ctx, "2")
+			require.NoError(t, err)
+			require.Len(t, activity, 1)
+			assert.Equal(t, "3", activity[0].GetPost().GetId())
+			charlieActivity, err = stores.users.ListUserActivity(ctx, "3")
+			require.NoError(t, err)
+			assert.Equal(t, "2", charlieActivity[0].GetComment().GetId())
+			assert.ErrorIs(t, stores.posts.DeletePost(ctx, "5", time.Now()), ErrNotFound)
+			assert.ErrorIs(t, stores.comments.DeleteComment(ctx, "1", time.Now()), ErrNotFound)
+
+			// ... but stay in the index, so they are back once restored
+			activity, err = stores.users.ListUserActivity(withDeleted(ctx), "2")
+			require.NoError(t, err)
+			require.Len(t, activity, 2)
+			assert.NotNil(t, activity[0].GetPost().GetDeletedAt())
+			assert.Equal(t, activity[0].GetPost().GetDeletedAt().GetValue(), activity[0].GetPost().GetUpdatedAt())
+			require.NoError(t, stores.posts.RestorePost(ctx, "5"))
+			activity, err = stores.users.ListUserActivity(ctx, "2")
+			require.NoError(t, err)
+			require.Len(t, activity, 2)
+			assert.Equal(t, "5", activity[0].GetPost().GetId())
+			assert.Nil(t, activity[0].GetPost().GetDeletedAt())
+
+			// Deleted users keep their activity index
+			require.NoError(t, stores.users.DeleteUser(ctx, "4", time.Now()))
+			_, err = stores.users.ListUserActivity(ctx, "4")
+			assert.ErrorIs(t, err, ErrNotFound)
+			assert.ErrorIs(t, stores.users.DeleteUser(ctx, "4", time.Now()), ErrNotFound)
+			_, err = stores.users.ListUserActivity(withDeleted(ctx), "4")
+			assert.NoError(t, err)
+
+			// recentActivity and ListUserActivity are resolved from the same index
+			users, err := stores.users.ListUsers(ctx)
+			require.NoError(t, err)
+			for _, user := range users {
+				activity, err := stores.users.ListUserActivity(ctx, user.Id)
+				require.NoError(t, err)
+				require.Len(t, user.RecentActivity, len(activity))
+				for i := range activity {
+					assert.True(t, proto.Equal(activity[i], user.RecentActivity[i]), "user %s item %d", user.Id, i)
+				}
+			}
+		})
+	}
+}
+
+func TestPostComments(t *testing.T) {
+	for name, config := range testStoreConfigs() {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			stores, err := openStore(config(t))
+			require.NoError(t, err)
+			defer stores.close()
+			seedTestStores(t, stores)
+
+			commentIDs := func(comments []*service.Comment) []string {
+				ids := make([]string, 0, len(comments))
+				for _, comment := range comments {
+					ids = append(ids, comment.Id)
+				}
+				return ids
+			}
+
+			// New comments are listed last, the comments field of posts is ignored
+			require.NoError(t, stores.comments.SaveComment(ctx, &service.Comment{Id: "5", Content: "New", AuthorId: "4", PostId: "1"}))
+			require.NoError(t, stores.posts.SavePost(ctx, &service.Post{Id: "1", Title: "Renamed", AuthorId: "1", Comments: []*service.Comment{{Id: "9"}}}))
+			post, err := stores.posts.GetPost(ctx, "1")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"1", "2", "5"}, commentIDs(post.Comments))
+
+			// Moving a comment to another post keeps its creation time
+			require.NoError(t, stores.comments.SaveComment(ctx, &service.Comment{Id: "2", Content: "Moved", AuthorId: "3", PostId: "3"}))
+			posts, err := stores.posts.ListPosts(ctx)
+			require.NoError(t, err)
+			byID := make(map[string]*service.Post, len(posts))
+			for _, post := range posts {
+				byID[post.Id] = post
+			}
+			assert.Equal(t, []string{"1", "5"}, commentIDs(byID["1"].Comments))
+			assert.Equal(t, []string{"2", "3", "4"}, commentIDs(byID["3"].Comments))
+			assert.Empty(t, byID["2"].Comments)
+
+			// Deleted comments are hidden unless deleted records are included
+			require.NoError(t, stores.comments.DeleteComment(ctx, "3", time.Now()))
+			post, err = stores.posts.GetPost(ctx, "3")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"2", "4"}, commentIDs(post.Comments))
+			post, err = stores.posts.GetPost(withDeleted(ctx), "3")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"2", "3", "4"}, commentIDs(post.Comments))
+
+			// Posts in the activity carry their comments as well
+			activity, err := stores.users.ListUserActivity(ctx, "2")
+			require.NoError(t, err)
+			for _, item := range activity {
+				if item.GetPost().GetId() == "3" {
+					assert.Equal(t, []string{"2", "4"}, commentIDs(item.GetPost().Comments))
+				}
+			}
+		})
+	}
+}
+
+func TestCompareActivity(t *testing.T) {
+	post := func(id, createdAt string) *service.ActivityItem {
+		return &service.ActivityItem{Value: &service.ActivityItem_Post{Post: &service.Post{Id: id, CreatedAt: createdAt}}}
+	}
+	comment := func(id, createdAt string) *service.ActivityItem {
+		return &service.ActivityItem{Value: &service.ActivityItem_Comment{Comment: &service.Comment{Id: id, CreatedAt: createdAt}}}
+	}
+
+	items := []*service.ActivityItem{
+		post("2", "2024-01-01T00:00:00.000Z"),
+		post("10", "2024-01-01T00:00:00.000Z"),
+		comment("1", "2024-01-01T00:00:00.000Z"),
+		post("1", "2024-02-01T00:00:00.000Z"),
+	}
+	sortActivity(items)
+
+	// Most recent first; items created at the same time by kind and descending ID
+	refs := make([]activityRef, 0, len(items))
+	for _, item := range items {
+		refs = append(refs, itemRef(item))
+	}
+	assert.Equal(t, []activityRef{
+		{Kind: activityKindPost, ID: "1"},
+		{Kind: activityKindComment, ID: "1"},
+		{Kind: activityKindPost, ID: "10"},
+		{Kind: activityKindPost, ID: "2"},
+	}, refs)
+}
+
+func TestActivityRefsEncoding(t *testing.T) {
+	refs := []activityRef{
+		{Kind: activityKindComment, ID: "4"},
+		{Kind: activityKindPost, ID: "2"},
+	}
+	decoded, err := decodeActivityRefs(encodeActivityRefs(refs))
+	require.NoError(t, err)
+	assert.Equal(t, refs, decoded)
+
+	decoded, err = decodeActivityRefs(nil)
+	require.NoError(t, err)
+	assert.Empty(t, decoded)
+
+	_, err = decodeActivityRefs([]byte("product:1"))
+	assert.Error(t, err)
+}
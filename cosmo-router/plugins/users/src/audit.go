@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// actorMetadataKey is the gRPC metadata key that names who made a request.
+// The router sends propagated HTTP headers as metadata, so a header rule that
+// forwards X-User-Id to the plugin makes the caller show up in the audit log.
+const actorMetadataKey = "x-user-id"
+
+// requestActor returns the actor named by the metadata of the request, or nil
+// if there is none
+func requestActor(ctx context.Context) *wrapperspb.StringValue {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, actor := range md.Get(actorMetadataKey) {
+		if actor != "" {
+			return wrapperspb.String(actor)
+		}
+	}
+	return nil
+}
+
+// withRequestViewer returns a context that makes store reads show the drafts
+// and archived posts of the actor of the request, and hide those of other users
+func withRequestViewer(ctx context.Context) context.Context {
+	return withViewer(ctx, requestActor(ctx).GetValue())
+}
+
+// checkAuthor returns a PermissionDenied status error unless the actor of the
+// request is the author of post. Only authors may change their posts.
+func checkAuthor(ctx context.Context, post *service.Post) error {
+	if requestActor(ctx).GetValue() != post.AuthorId {
+		return permissionDenied("NOT_AUTHOR", fmt.Sprintf("post %q can only be changed by its author", post.Id))
+	}
+	return nil
+}
+
+// withDeletedForAdmin returns a context that makes store reads include deleted
+// records if include is true. Deleted records are only shown to admins, so it
+// returns a PermissionDenied status error unless the actor of the request is
+// a user with the ADMIN role.
+func (s *UsersService) withDeletedForAdmin(ctx context.Context, include *wrapperspb.BoolValue) (context.Context, error) {
+	if !include.GetValue() {
+		return ctx, nil
+	}
+	denied := permissionDenied("ADMIN_REQUIRED", "includeDeleted is only available to admins")
+	actor := requestActor(ctx)
+	if actor == nil {
+		return nil, denied
+	}
+	user, err := s.users.GetUser(ctx, actor.Value)
+	if errors.Is(err, ErrNotFound) {
+		return nil, denied
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get actor %s: %w", actor.Value, err))
+	}
+	if user.Role != service.UserRole_USER_ROLE_ADMIN {
+		return nil, denied
+	}
+	return withDeleted(ctx), nil
+}
+
+// newAuditRecord returns an audit record of a change of the user with the
+// given ID, made by the actor of the request. The store assigns the ID.
+func newAuditRecord(ctx context.Context, action service.AuditAction, userID string, diff userDiff, now time.Time) *service.AuditRecord {
+	record := &service.AuditRecord{
+		UserId:    userID,
+		Action:    action,
+		Actor:     requestActor(ctx),
+		Timestamp: formatTimestamp(now),
+		Changes:   make([]*service.FieldChange, 0, len(diff)),
+	}
+	for _, change := range diff {
+		record.Changes = append(record.Changes, &service.FieldChange{
+			Field:    change.Field,
+			OldValue: jsonValue(change.Old),
+			NewValue: jsonValue(change.New),
+		})
+	}
+	return record
+}
+
+// jsonValue encodes a value of a field change as JSON, or returns nil for null
+func jsonValue(v any) *wrapperspb.StringValue {
+	if v == nil {
+		return nil
+	}
+	// Diff values are strings, numbers, lists and maps of them, which always encode
+	data, _ := json.Marshal(v)
+	return wrapperspb.String(string(data))
+}
+
+// createdUserDiff returns the fields of a created user as changes from null.
+// Fields that are empty are left out.
+func createdUserDiff(user *service.User) userDiff {
+	diff := diffUsers(&service.User{}, user)
+	for i := range diff {
+		diff[i].Old = nil
+	}
+	return diff
+}
+
+// createdPostDiff returns the fields of a created post as changes from null
+func createdPostDiff(post *service.Post) userDiff {
+	return userDiff{
+		{Field: "id", New: post.Id},
+		{Field: "title", New: post.Title},
+		{Field: "status", New: enumValueName(post.Status.String(), "POST_STATUS_")},
+	}
+}
+
+// postDiff returns the changes from before to after of a post. The ID is
+// always listed, so records tell which post of the user was changed.
+func postDiff(before, after *service.Post) userDiff {
+	diff := userDiff{{Field: "id", Old: before.Id, New: after.Id}}
+	if before.Title != after.Title {
+		diff = append(diff, fieldChange{Field: "title", Old: before.Title, New: after.Title})
+	}
+	if before.Status != after.Status {
+		diff = append(diff, fieldChange{
+			Field: "status",
+			Old:   enumValueName(before.Status.String(), "POST_STATUS_"),
+			New:   enumValueName(after.Status.String(), "POST_STATUS_"),
+		})
+	}
+	if before.GetDeletedAt().GetValue() != after.GetDeletedAt().GetValue() {
+		diff = append(diff, fieldChange{Field: "deletedAt", Old: stringValue(before.DeletedAt), New: stringValue(after.DeletedAt)})
+	}
+	return diff
+}
+
+// createdCommentDiff returns the fields of a created comment as changes from null
+func createdCommentDiff(comment *service.Comment) userDiff {
+	return userDiff{
+		{Field: "id", New: comment.Id},
+		{Field: "postId", New: comment.PostId},
+		{Field: "content", New: comment.Content},
+	}
+}
+
+// commentDiff returns the changes from before to after of a comment. The ID is
+// always listed, so records tell which comment of the user was changed.
+func commentDiff(before, after *service.Comment) userDiff {
+	diff := userDiff{{Field: "id", Old: before.Id, New: after.Id}}
+	if before.Content != after.Content {
+		diff = append(diff, fieldChange{Field: "content", Old: before.Content, New: after.Content})
+	}
+	if before.GetDeletedAt().GetValue() != after.GetDeletedAt().GetValue() {
+		diff = append(diff, fieldChange{Field: "deletedAt", Old: stringValue(before.DeletedAt), New: stringValue(after.DeletedAt)})
+	}
+	return diff
+}
+
+// compareAuditRecords orders audit records most recent first
+func compareAuditRecords(a, b *service.AuditRecord) int {
+	return compareIDs(b.Id, a.Id)
+}
+
+// auditCursorCodec is the codec for cursors of a user history. A cursor stores
+// the ID of an audit record.
+var auditCursorCodec = cursorCodec[*service.AuditRecord]{
+	kind: "audit",
+	encode: func(record *service.AuditRecord) string {
+		return record.Id
+	},
+	decode: func(key string) (*service.AuditRecord, error) {
+		if _, err := strconv.ParseUint(key, 10, 64); err != nil {
+			return nil, err
+		}
+		return &service.AuditRecord{Id: key}, nil
+	},
+}
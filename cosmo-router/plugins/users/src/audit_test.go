@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// auditChanges returns the changes of an audit record as field -> [old, new],
+// with "null" for null values
+func auditChanges(record *service.AuditRecord) map[string][2]string {
+	value := func(v *wrapperspb.StringValue) string {
+		if v == nil {
+			return "null"
+		}
+		return v.Value
+	}
+	changes := make(map[string][2]string, len(record.Changes))
+	for _, change := range record.Changes {
+		changes[change.Field] = [2]string{value(change.OldValue), value(change.NewValue)}
+	}
+	return changes
+}
+
+func TestNewAuditRecord(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(actorMetadataKey, "admin@example.com"))
+	now := time.Date(2024, 5, 1, 12, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	diff := userDiff{
+		{Field: "role", Old: "USER", New: "ADMIN"},
+		{Field: "tags", Old: nil, New: []string{"a", "b"}},
+		{Field: "age", Old: int32(30), New: nil},
+		{Field: "profile", Old: nil, New: map[string]any{"displayName": "Al", "timezone": nil, "theme": "DARK"}},
+	}
+	record := newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_UPDATE_USER, "1", diff, now)
+
+	assert.Equal(t, "1", record.UserId)
+	assert.Equal(t, "admin@example.com", record.Actor.GetValue())
+	assert.Equal(t, "2024-05-01T10:30:00.000Z", record.Timestamp)
+	assert.Equal(t, map[string][2]string{
+		"role":    {`"USER"`, `"ADMIN"`},
+		"tags":    {"null", `["a","b"]`},
+		"age":     {"30", "null"},
+		"profile": {"null", `{"displayName":"Al","theme":"DARK","timezone":null}`},
+	}, auditChanges(record))
+
+	// Requests without metadata have no actor
+	record = newAuditRecord(context.Background(), service.AuditAction_AUDIT_ACTION_UPDATE_USER, "1", nil, now)
+	assert.Nil(t, record.Actor)
+	assert.Empty(t, record.Changes)
+}
+
+func TestCreatedUserDiff(t *testing.T) {
+	user := &service.User{
+		Id:          "7",
+		Name:        "Grace",
+		Email:       "grace@example.com",
+		Role:        service.UserRole_USER_ROLE_GUEST,
+		Permissions: []string{},
+		Age:         wrapperspb.Int32(41),
+	}
+
+	created := createdUserDiff(user)
+	assert.Equal(t, []string{"name", "email", "role", "age"}, created.fields(), "empty fields are left out")
+	for _, change := range created {
+		assert.Nil(t, change.Old, change.Field)
+	}
+	assert.Equal(t, "GUEST", created[2].New)
+}
+
+func TestQueryUserHistory(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	ctx := metadata.AppendToOutgoingContext(context.Background(), actorMetadataKey, "ops@example.com")
+
+	history := func(t *testing.T, userID string, first int32, after string) *service.AuditRecordConnection {
+		t.Helper()
+		req := &service.QueryUserHistoryRequest{UserId: userID, First: wrapperspb.Int32(first)}
+		if after != "" {
+			req.After = wrapperspb.String(after)
+		}
+		resp, err := svc.usersClient.QueryUserHistory(ctx, req)
+		require.NoError(t, err)
+		return resp.UserHistory
+	}
+
+	// Seeded users have no history yet
+	assert.Empty(t, history(t, "1", 10, "").Edges)
+
+	_, err := svc.usersClient.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: &service.UserInput{
+		Id: "1", Role: service.UserRole_USER_ROLE_USER,
+	}})
+	require.NoError(t, err)
+	_, err = svc.usersClient.MutationUpdateUser(context.Background(), &service.MutationUpdateUserRequest{Input: &service.UserInput{
+		Id: "1", Name: wrapperspb.String("Alice Johnson"), Unset: unsetUser(service.NullableUserField_NULLABLE_USER_FIELD_BIO),
+	}})
+	require.NoError(t, err)
+	_, err = svc.usersClient.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{Input: []*service.UserInput{
+		{Id: "1", Role: service.UserRole_USER_ROLE_ADMIN},
+		{Id: "1", Age: wrapperspb.Int32(31)},
+		{Id: "2", Name: wrapperspb.String("Bob Smith")},
+	}})
+	require.NoError(t, err)
+	_, err = svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{Input: &service.PostInput{Title: "Audited", AuthorId: "1"}})
+	require.NoError(t, err)
+
+	t.Run("most recent first", func(t *testing.T) {
+		connection := history(t, "1", 10, "")
+		assert.Equal(t, int32(4), connection.TotalCount)
+		require.Len(t, connection.Edges, 4)
+
+		post, batch, bio, role := connection.Edges[0].Node, connection.Edges[1].Node, connection.Edges[2].Node, connection.Edges[3].Node
+		assert.Equal(t, service.AuditAction_AUDIT_ACTION_CREATE_POST, post.Action)
+		assert.Equal(t, `"Audited"`, auditChanges(post)["title"][1])
+
+		// Several inputs of a batch are recorded as one change
+		assert.Equal(t, service.AuditAction_AUDIT_ACTION_UPDATE_USER, batch.Action)
+		assert.Equal(t, map[string][2]string{"role": {`"USER"`, `"ADMIN"`}, "age": {"28", "31"}}, auditChanges(batch))
+
+		// Fields given with their current value are not recorded
+		assert.Equal(t, map[string][2]string{"bio": {`"Full-stack developer with 5+ years of experience"`, "null"}}, auditChanges(bio))
+		assert.Nil(t, bio.Actor, "the request didn't name an actor")
+
+		assert.Equal(t, map[string][2]string{"role": {`"ADMIN"`, `"USER"`}}, auditChanges(role))
+		assert.Equal(t, "ops@example.com", role.Actor.GetValue())
+		assert.Equal(t, "1", role.UserId)
+		_, err := time.Parse(time.RFC3339, role.Timestamp)
+		require.NoError(t, err)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		first := history(t, "1", 3, "")
+		require.Len(t, first.Edges, 3)
+		assert.True(t, first.PageInfo.HasNextPage)
+
+		rest := history(t, "1", 3, first.PageInfo.EndCursor.GetValue())
+		require.Len(t, rest.Edges, 1)
+		assert.False(t, rest.PageInfo.HasNextPage)
+		assert.Equal(t, service.AuditAction_AUDIT_ACTION_UPDATE_USER, rest.Edges[0].Node.Action)
+		assert.Equal(t, "ops@example.com", rest.Edges[0].Node.Actor.GetValue())
+	})
+
+	t.Run("created, deleted and restored users", func(t *testing.T) {
+		created, err := svc.usersClient.MutationCreateUser(ctx, &service.MutationCreateUserRequest{Input: &service.CreateUserInput{
+			Name: "Dana", Email: "dana@example.com", Role: service.UserRole_USER_ROLE_GUEST,
+		}})
+		require.NoError(t, err)
+		id := created.CreateUser.Id
+		_, err = svc.usersClient.MutationDeleteUser(ctx, &service.MutationDeleteUserRequest{Id: id})
+		require.NoError(t, err)
+
+		// The history outlives the user
+		connection := history(t, id, 10, "")
+		require.Len(t, connection.Edges, 2)
+		deleted, createdRecord := connection.Edges[0].Node, connection.Edges[1].Node
+		assert.Equal(t, service.AuditAction_AUDIT_ACTION_CREATE_USER, createdRecord.Action)
+		assert.Equal(t, [2]string{"null", `"Dana"`}, auditChanges(createdRecord)["name"])
+		assert.Equal(t, service.AuditAction_AUDIT_ACTION_DELETE_USER, deleted.Action)
+		require.Len(t, deleted.Changes, 1)
+		assert.Equal(t, "deletedAt", deleted.Changes[0].Field)
+		assert.Nil(t, deleted.Changes[0].OldValue)
+		assert.Equal(t, `"`+deleted.Timestamp+`"`, deleted.Changes[0].NewValue.GetValue())
+
+		_, err = svc.usersClient.MutationRestoreUser(ctx, &service.MutationRestoreUserRequest{Id: id})
+		require.NoError(t, err)
+		restored := history(t, id, 1, "").Edges[0].Node
+		assert.Equal(t, service.AuditAction_AUDIT_ACTION_RESTORE_USER, restored.Action)
+		assert.Equal(t, [2]string{`"` + deleted.Timestamp + `"`, "null"}, auditChanges(restored)["deletedAt"])
+	})
+
+	t.Run("failed mutations are not recorded", func(t *testing.T) {
+		_, err := svc.usersClient.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{Atomic: wrapperspb.Bool(true), Input: []*service.UserInput{
+			{Id: "2", Name: wrapperspb.String("Robert")},
+			{Id: "999", Name: wrapperspb.String("Nobody")},
+		}})
+		require.NoError(t, err)
+		_, err = svc.usersClient.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: &service.UserInput{Id: "2", Age: wrapperspb.Int32(-1)}})
+		require.Error(t, err)
+
+		assert.Empty(t, history(t, "2", 10, "").Edges)
+		assert.Empty(t, history(t, "999", 10, "").Edges)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := svc.usersClient.QueryUserHistory(ctx, &service.QueryUserHistoryRequest{UserId: "1", First: wrapperspb.Int32(-1)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = svc.usersClient.QueryUserHistory(ctx, &service.QueryUserHistoryRequest{UserId: "1", After: wrapperspb.String(encodeCursor("user", "1"))})
+		assert.Contains(t, fieldViolations(t, err), "after")
+	})
+}
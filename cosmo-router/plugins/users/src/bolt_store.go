@@ -0,0 +1,674 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Interface guards to ensure that BoltStore implements all store interfaces
+var (
+	_ UserStore    = (*BoltStore)(nil)
+	_ PostStore    = (*BoltStore)(nil)
+	_ CommentStore = (*BoltStore)(nil)
+	_ AuditStore   = (*BoltStore)(nil)
+)
+
+// Bucket names used by the bolt store
+var (
+	usersBucket    = []byte("users")
+	activityBucket = []byte("activity")
+	postsBucket    = []byte("posts")
+	commentsBucket = []byte("comments")
+	auditBucket    = []byte("audit")
+
+	// postCommentsBucket holds the refs to the comments of each post
+	postCommentsBucket = []byte("post_comments")
+)
+
+// BoltStore persists users, posts and comments in an embedded bbolt database file.
+// Every record is stored as a protobuf-encoded message keyed by its ID, so the
+// data survives restarts of the plugin process. The activity index and the
+// comments of each post are kept in their own buckets and updated in the same
+// transaction as the posts and comments.
+// Audit records are kept in one nested bucket per user, keyed by their ID in
+// big-endian byte order, so a cursor walks them in the order they were added.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// OpenBoltStore opens (or creates) the database file at the given path
+func OpenBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt store %s: %w", path, err)
+	}
+
+	// Make sure all buckets exist before the first read
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{usersBucket, activityBucket, postsBucket, commentsBucket, auditBucket, postCommentsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize bolt store %s: %w", path, err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Close closes the underlying database file
+func (b *BoltStore) Close() error {
+	return b.db.Close()
+}
+
+// GetUser returns the user with the given ID, with recentActivity resolved
+func (b *BoltStore) GetUser(ctx context.Context, id string) (*service.User, error) {
+	user := &service.User{}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		if err := boltGet(tx, usersBucket, id, user); err != nil {
+			return err
+		}
+		if !visible(ctx, user.DeletedAt) {
+			return ErrNotFound
+		}
+		activity, err := boltResolveActivity(ctx, tx, id)
+		user.RecentActivity = activity
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ListUsers returns all users in the store, with recentActivity resolved
+func (b *BoltStore) ListUsers(ctx context.Context) ([]*service.User, error) {
+	var users []*service.User
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return boltList(tx, usersBucket, func(data []byte) error {
+			user := &service.User{}
+			if err := boltDecode(data, user); err != nil {
+				return err
+			}
+			if !visible(ctx, user.DeletedAt) {
+				return nil
+			}
+			activity, err := boltResolveActivity(ctx, tx, user.Id)
+			if err != nil {
+				return err
+			}
+			user.RecentActivity = activity
+			users = append(users, user)
+			return nil
+		})
+	})
+	return users, err
+}
+
+// SaveUser creates or replaces a user. The recentActivity field is ignored.
+func (b *BoltStore) SaveUser(ctx context.Context, user *service.User) error {
+	return b.SaveUsers(ctx, []*service.User{user})
+}
+
+// SaveUsers creates or replaces several users in one transaction, which is
+// rolled back if any of them can't be saved. The recentActivity field is ignored.
+func (b *BoltStore) SaveUsers(ctx context.Context, users []*service.User) error {
+	now := time.Now()
+	stamped := make([]*service.User, len(users))
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		for i, user := range users {
+			var current *service.User
+			existing := &service.User{}
+			switch err := boltGet(tx, usersBucket, user.Id, existing); {
+			case err == nil:
+				current = existing
+			case !errors.Is(err, ErrNotFound):
+				return err
+			}
+
+			stored := proto.Clone(user).(*service.User)
+			stored.RecentActivity = nil
+			if err := stampUser(stored, current, now); err != nil {
+				return err
+			}
+			if err := boltPut(tx, usersBucket, stored.Id, stored); err != nil {
+				return err
+			}
+			stamped[i] = stored
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Only report the new versions once they are committed
+	for i, user := range users {
+		user.Version, user.UpdatedAt = stamped[i].Version, stamped[i].UpdatedAt
+	}
+	return nil
+}
+
+// ListUserActivity returns the posts and comments of a user, most recently
+// created first
+func (b *BoltStore) ListUserActivity(ctx context.Context, userID string) ([]*service.ActivityItem, error) {
+	var items []*service.ActivityItem
+	err := b.db.View(func(tx *bolt.Tx) error {
+		user := &service.User{}
+		if err := boltGet(tx, usersBucket, userID, user); err != nil {
+			return err
+		}
+		if !visible(ctx, user.DeletedAt) {
+			return ErrNotFound
+		}
+		var err error
+		items, err = boltResolveActivity(ctx, tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// DeleteUser marks a user as deleted at the given time
+func (b *BoltStore) DeleteUser(ctx context.Context, id string, at time.Time) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		user := &service.User{}
+		if err := boltGet(tx, usersBucket, id, user); err != nil {
+			return err
+		}
+		if user.DeletedAt != nil {
+			return ErrNotFound
+		}
+		deleted := proto.Clone(user).(*service.User)
+		deleted.DeletedAt = wrapperspb.String(formatTimestamp(at))
+		if err := stampUser(deleted, user, at); err != nil {
+			return err
+		}
+		return boltPut(tx, usersBucket, id, deleted)
+	})
+}
+
+// RestoreUser clears the tombstone of a deleted user
+func (b *BoltStore) RestoreUser(ctx context.Context, id string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		user := &service.User{}
+		if err := boltGet(tx, usersBucket, id, user); err != nil {
+			return err
+		}
+		if user.DeletedAt == nil {
+			return nil
+		}
+		restored := proto.Clone(user).(*service.User)
+		restored.DeletedAt = nil
+		if err := stampUser(restored, user, time.Now()); err != nil {
+			return err
+		}
+		return boltPut(tx, usersBucket, id, restored)
+	})
+}
+
+// GetPost returns the post with the given ID, with comments resolved
+func (b *BoltStore) GetPost(ctx context.Context, id string) (*service.Post, error) {
+	post := &service.Post{}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		if err := boltGet(tx, postsBucket, id, post); err != nil {
+			return err
+		}
+		if !visiblePost(ctx, post) {
+			return ErrNotFound
+		}
+		comments, err := boltResolveComments(ctx, tx, id)
+		post.Comments = comments
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// ListPosts returns all posts in the store, with comments resolved
+func (b *BoltStore) ListPosts(ctx context.Context) ([]*service.Post, error) {
+	var posts []*service.Post
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return boltList(tx, postsBucket, func(data []byte) error {
+			post := &service.Post{}
+			if err := boltDecode(data, post); err != nil {
+				return err
+			}
+			if !visiblePost(ctx, post) {
+				return nil
+			}
+			comments, err := boltResolveComments(ctx, tx, post.Id)
+			if err != nil {
+				return err
+			}
+			post.Comments = comments
+			posts = append(posts, post)
+			return nil
+		})
+	})
+	return posts, err
+}
+
+// SavePost creates or replaces a post and sets its createdAt and updatedAt.
+// New posts are added to the activity index of their author. The comments
+// field is ignored.
+func (b *BoltStore) SavePost(ctx context.Context, post *service.Post) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		var previousAuthorID, storedCreatedAt string
+		existing := &service.Post{}
+		switch err := boltGet(tx, postsBucket, post.Id, existing); {
+		case err == nil:
+			previousAuthorID, storedCreatedAt = existing.AuthorId, existing.CreatedAt
+		case !errors.Is(err, ErrNotFound):
+			return err
+		}
+
+		now := time.Now()
+		post.CreatedAt = creationTime(post.CreatedAt, storedCreatedAt, now)
+		post.UpdatedAt = formatTimestamp(now)
+
+		stored := proto.Clone(post).(*service.Post)
+		stored.Comments = nil
+		if err := boltPut(tx, postsBucket, post.Id, stored); err != nil {
+			return err
+		}
+		return boltIndexRef(tx, activityBucket, activityRef{Kind: activityKindPost, ID: post.Id}, previousAuthorID, post.AuthorId)
+	})
+}
+
+// DeletePost marks a post as deleted at the given time
+func (b *BoltStore) DeletePost(ctx context.Context, id string, at time.Time) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		post := &service.Post{}
+		if err := boltGet(tx, postsBucket, id, post); err != nil {
+			return err
+		}
+		if post.DeletedAt != nil {
+			return ErrNotFound
+		}
+		post.DeletedAt = wrapperspb.String(formatTimestamp(at))
+		post.UpdatedAt = formatTimestamp(at)
+		return boltPut(tx, postsBucket, id, post)
+	})
+}
+
+// RestorePost clears the tombstone of a deleted post
+func (b *BoltStore) RestorePost(ctx context.Context, id string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		post := &service.Post{}
+		if err := boltGet(tx, postsBucket, id, post); err != nil {
+			return err
+		}
+		if post.DeletedAt == nil {
+			return nil
+		}
+		post.DeletedAt = nil
+		post.UpdatedAt = formatTimestamp(time.Now())
+		return boltPut(tx, postsBucket, id, post)
+	})
+}
+
+// GetComment returns the comment with the given ID
+func (b *BoltStore) GetComment(ctx context.Context, id string) (*service.Comment, error) {
+	comment := &service.Comment{}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		if err := boltGet(tx, commentsBucket, id, comment); err != nil {
+			return err
+		}
+		post, err := boltCommentPost(tx, comment)
+		if err != nil {
+			return err
+		}
+		if !visibleComment(ctx, comment, post) {
+			return ErrNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// ListComments returns all comments in the store
+func (b *BoltStore) ListComments(ctx context.Context) ([]*service.Comment, error) {
+	var comments []*service.Comment
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return boltList(tx, commentsBucket, func(data []byte) error {
+			comment := &service.Comment{}
+			if err := boltDecode(data, comment); err != nil {
+				return err
+			}
+			post, err := boltCommentPost(tx, comment)
+			if err != nil {
+				return err
+			}
+			if visibleComment(ctx, comment, post) {
+				comments = append(comments, comment)
+			}
+			return nil
+		})
+	})
+	return comments, err
+}
+
+// SaveComment creates or replaces a comment and sets its createdAt and updatedAt.
+// New comments are added to the activity index of their author and to the
+// comments of their post.
+func (b *BoltStore) SaveComment(ctx context.Context, comment *service.Comment) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		var previousAuthorID, previousPostID, storedCreatedAt string
+		existing := &service.Comment{}
+		switch err := boltGet(tx, commentsBucket, comment.Id, existing); {
+		case err == nil:
+			previousAuthorID, previousPostID, storedCreatedAt = existing.AuthorId, existing.PostId, existing.CreatedAt
+		case !errors.Is(err, ErrNotFound):
+			return err
+		}
+
+		now := time.Now()
+		comment.CreatedAt = creationTime(comment.CreatedAt, storedCreatedAt, now)
+		comment.UpdatedAt = formatTimestamp(now)
+
+		if err := boltPut(tx, commentsBucket, comment.Id, comment); err != nil {
+			return err
+		}
+		ref := activityRef{Kind: activityKindComment, ID: comment.Id}
+		if err := boltIndexRef(tx, activityBucket, ref, previousAuthorID, comment.AuthorId); err != nil {
+			return err
+		}
+		return boltIndexRef(tx, postCommentsBucket, ref, previousPostID, comment.PostId)
+	})
+}
+
+// DeleteComment marks a comment as deleted at the given time
+func (b *BoltStore) DeleteComment(ctx context.Context, id string, at time.Time) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		comment := &service.Comment{}
+		if err := boltGet(tx, commentsBucket, id, comment); err != nil {
+			return err
+		}
+		if comment.DeletedAt != nil {
+			return ErrNotFound
+		}
+		comment.DeletedAt = wrapperspb.String(formatTimestamp(at))
+		comment.UpdatedAt = formatTimestamp(at)
+		return boltPut(tx, commentsBucket, id, comment)
+	})
+}
+
+// RestoreComment clears the tombstone of a deleted comment
+func (b *BoltStore) RestoreComment(ctx context.Context, id string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		comment := &service.Comment{}
+		if err := boltGet(tx, commentsBucket, id, comment); err != nil {
+			return err
+		}
+		if comment.DeletedAt == nil {
+			return nil
+		}
+		comment.DeletedAt = nil
+		comment.UpdatedAt = formatTimestamp(time.Now())
+		return boltPut(tx, commentsBucket, id, comment)
+	})
+}
+
+// AppendAuditRecords assigns IDs to the records and appends them to the log
+// in one transaction
+func (b *BoltStore) AppendAuditRecords(ctx context.Context, records []*service.AuditRecord) error {
+	ids := make([]string, len(records))
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		audit := tx.Bucket(auditBucket)
+		for i, record := range records {
+			seq, err := audit.NextSequence()
+			if err != nil {
+				return err
+			}
+			history, err := audit.CreateBucketIfNotExists([]byte(record.UserId))
+			if err != nil {
+				return err
+			}
+
+			stored := proto.Clone(record).(*service.AuditRecord)
+			stored.Id = strconv.FormatUint(seq, 10)
+			data, err := proto.Marshal(stored)
+			if err != nil {
+				return fmt.Errorf("failed to encode audit record %s: %w", stored.Id, err)
+			}
+			if err := history.Put(binary.BigEndian.AppendUint64(nil, seq), data); err != nil {
+				return err
+			}
+			ids[i] = stored.Id
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, record := range records {
+		record.Id = ids[i]
+	}
+	return nil
+}
+
+// ListAuditRecords returns the audit records of a user, most recent first
+func (b *BoltStore) ListAuditRecords(ctx context.Context, userID string) ([]*service.AuditRecord, error) {
+	records := []*service.AuditRecord{}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		history := tx.Bucket(auditBucket).Bucket([]byte(userID))
+		if history == nil {
+			return nil
+		}
+		cursor := history.Cursor()
+		for k, v := cursor.Last(); k != nil; k, v = cursor.Prev() {
+			record := &service.AuditRecord{}
+			if err := boltDecode(v, record); err != nil {
+				return fmt.Errorf("failed to decode audit record of user %s: %w", userID, err)
+			}
+			records = append(records, record)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// boltResolveActivity converts the stored activity index of a user into
+// activity items, most recently created first, skipping deleted posts and
+// comments unless ctx includes them
+func boltResolveActivity(ctx context.Context, tx *bolt.Tx, userID string) ([]*service.ActivityItem, error) {
+	refs, err := decodeActivityRefs(tx.Bucket(activityBucket).Get([]byte(userID)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode activity of user %s: %w", userID, err)
+	}
+
+	items := make([]*service.ActivityItem, 0, len(refs))
+	for _, ref := range refs {
+		switch ref.Kind {
+		case activityKindPost:
+			post := &service.Post{}
+			if err := boltGet(tx, postsBucket, ref.ID, post); err != nil || !visiblePost(ctx, post) {
+				continue
+			}
+			comments, err := boltResolveComments(ctx, tx, post.Id)
+			if err != nil {
+				return nil, err
+			}
+			post.Comments = comments
+			items = append(items, &service.ActivityItem{Value: &service.ActivityItem_Post{Post: post}})
+		case activityKindComment:
+			comment := &service.Comment{}
+			if err := boltGet(tx, commentsBucket, ref.ID, comment); err != nil {
+				continue
+			}
+			post, err := boltCommentPost(tx, comment)
+			if err != nil {
+				return nil, err
+			}
+			if visibleComment(ctx, comment, post) {
+				items = append(items, &service.ActivityItem{Value: &service.ActivityItem_Comment{Comment: comment}})
+			}
+		}
+	}
+	sortActivity(items)
+	return items, nil
+}
+
+// boltCommentPost returns the post of a comment for visibleComment, or nil if
+// the post doesn't exist
+func boltCommentPost(tx *bolt.Tx, comment *service.Comment) (*service.Post, error) {
+	post := &service.Post{}
+	err := boltGet(tx, postsBucket, comment.PostId, post)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// boltResolveComments returns the comments of a post, oldest first, skipping
+// deleted comments unless ctx includes them
+func boltResolveComments(ctx context.Context, tx *bolt.Tx, postID string) ([]*service.Comment, error) {
+	refs, err := decodeActivityRefs(tx.Bucket(postCommentsBucket).Get([]byte(postID)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode comments of post %s: %w", postID, err)
+	}
+
+	comments := make([]*service.Comment, 0, len(refs))
+	for _, ref := range refs {
+		comment := &service.Comment{}
+		if err := boltGet(tx, commentsBucket, ref.ID, comment); err == nil && visible(ctx, comment.DeletedAt) {
+			comments = append(comments, comment)
+		}
+	}
+	slices.SortFunc(comments, compareComments)
+	return comments, nil
+}
+
+// boltIndexRef moves ref from the refs stored under key from to the front of
+// the refs stored under key to in the given bucket, like moveRef does in memory
+func boltIndexRef(tx *bolt.Tx, bucket []byte, ref activityRef, from, to string) error {
+	if from == to {
+		// Existing records keep their position in the index
+		return nil
+	}
+
+	index := tx.Bucket(bucket)
+	if from != "" {
+		refs, err := decodeActivityRefs(index.Get([]byte(from)))
+		if err != nil {
+			return err
+		}
+		if err := index.Put([]byte(from), encodeActivityRefs(removeActivity(refs, ref))); err != nil {
+			return err
+		}
+	}
+
+	refs, err := decodeActivityRefs(index.Get([]byte(to)))
+	if err != nil {
+		return err
+	}
+	return index.Put([]byte(to), encodeActivityRefs(prependActivity(refs, ref)))
+}
+
+// boltGet decodes the record stored under key into msg.
+// Returns ErrNotFound if the key doesn't exist.
+func boltGet(tx *bolt.Tx, bucket []byte, key string, msg proto.Message) error {
+	data := tx.Bucket(bucket).Get([]byte(key))
+	if data == nil {
+		return ErrNotFound
+	}
+	// Data is only valid during the transaction, Unmarshal copies it
+	if err := boltDecode(data, msg); err != nil {
+		return fmt.Errorf("failed to decode %s/%s: %w", bucket, key, err)
+	}
+	return nil
+}
+
+// boltDecode decodes a stored record into msg. Records that can't be decoded
+// are reported as ErrCorrupt.
+func boltDecode(data []byte, msg proto.Message) error {
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return fmt.Errorf("%w: %w", ErrCorrupt, err)
+	}
+	return nil
+}
+
+// boltList calls fn with the encoded value of every record in the bucket
+func boltList(tx *bolt.Tx, bucket []byte, fn func(data []byte) error) error {
+	return tx.Bucket(bucket).ForEach(func(k, v []byte) error {
+		if err := fn(v); err != nil {
+			return fmt.Errorf("failed to decode %s/%s: %w", bucket, k, err)
+		}
+		return nil
+	})
+}
+
+// boltPut encodes msg and stores it under key
+func boltPut(tx *bolt.Tx, bucket []byte, key string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s/%s: %w", bucket, key, err)
+	}
+	records := tx.Bucket(bucket)
+	if err := records.Put([]byte(key), data); err != nil {
+		return err
+	}
+	// Records saved with a numeric ID, e.g. from the seed, advance the ID sequence
+	// past it, so NextID doesn't hand the ID out again once the record is deleted
+	if seq, err := strconv.ParseUint(key, 10, 64); err == nil && seq > records.Sequence() {
+		return records.SetSequence(seq)
+	}
+	return nil
+}
+
+// NextID returns the next number of the per-kind sequence. The sequence is
+// persisted in the database and advanced by every saved record, so IDs are never
+// handed out twice, even across restarts or after the record has been deleted.
+func (b *BoltStore) NextID(ctx context.Context, kind string) (string, error) {
+	var bucket []byte
+	switch kind {
+	case idKindUser:
+		bucket = usersBucket
+	case idKindPost:
+		bucket = postsBucket
+	case idKindComment:
+		bucket = commentsBucket
+	default:
+		return "", fmt.Errorf("unknown ID kind %q", kind)
+	}
+
+	var id string
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		seq, err := tx.Bucket(bucket).NextSequence()
+		id = strconv.FormatUint(seq, 10)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to advance %s sequence: %w", kind, err)
+	}
+	return id, nil
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestBoltStoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store, err := OpenBoltStore(filepath.Join(t.TempDir(), "users.db"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	_, err = store.GetUser(ctx, "1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.ListUserActivity(ctx, "1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.GetPost(ctx, "1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.GetComment(ctx, "1")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	seedTestStores(t, &storeSet{users: store, posts: store, comments: store})
+
+	user, err := store.GetUser(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Johnson", user.Name)
+	assert.Equal(t, "Alice J.", user.Profile.GetDisplayName().GetValue())
+	assert.Len(t, user.SkillCategories.GetList().GetItems(), 3)
+
+	users, err := store.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Len(t, users, 4)
+
+	activity, err := store.ListUserActivity(ctx, "1")
+	require.NoError(t, err)
+	require.Len(t, activity, 3)
+	assert.Equal(t, "4", activity[0].GetComment().GetId())
+	assert.Equal(t, "2", activity[1].GetPost().GetId())
+	assert.Equal(t, "1", activity[2].GetPost().GetId())
+
+	posts, err := store.ListPosts(ctx)
+	require.NoError(t, err)
+	assert.Len(t, posts, 4)
+
+	comments, err := store.ListComments(ctx)
+	require.NoError(t, err)
+	assert.Len(t, comments, 4)
+}
+
+func TestBoltStoreSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	cfg := &Config{StoreBackend: storeBackendBolt, StorePath: filepath.Join(t.TempDir(), "users.db")}
+
+	// First plugin run: seed the store and apply mutations through the service
+	stores, err := openStore(cfg)
+	require.NoError(t, err)
+	seedTestStores(t, stores)
+	svc := setupTestServiceWithStores(t, stores)
+
+	_, err = svc.usersClient.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{
+		Input: &service.UserInput{Id: "1", Name: &wrapperspb.StringValue{Value: "Alice Persisted"}},
+	})
+	require.NoError(t, err)
+
+	created, err := svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{
+		Input: &service.PostInput{Title: "Persisted Post", AuthorId: "2"},
+	})
+	require.NoError(t, err)
+
+	svc.cleanup()
+	require.NoError(t, stores.close())
+
+	// Second plugin run: the seed must not overwrite the persisted changes
+	stores, err = openStore(cfg)
+	require.NoError(t, err)
+	seedTestStores(t, stores)
+	svc = setupTestServiceWithStores(t, stores)
+	defer func() {
+		svc.cleanup()
+		stores.close()
+	}()
+
+	userResp, err := svc.usersClient.QueryUser(ctx, &service.QueryUserRequest{Id: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Persisted", userResp.User.Name)
+
+	post, err := stores.posts.GetPost(ctx, created.CreatePost.Id)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(created.CreatePost, post))
+
+	activityResp, err := svc.usersClient.QueryUserActivity(ctx, &service.QueryUserActivityRequest{UserId: "2"})
+	require.NoError(t, err)
+	require.NotEmpty(t, activityResp.UserActivity)
+	assert.Equal(t, created.CreatePost.Id, activityResp.UserActivity[0].GetPost().GetId())
+}
+
+func TestBoltStoreSaveUsersIsAtomic(t *testing.T) {
+	ctx := context.Background()
+	store, err := OpenBoltStore(filepath.Join(t.TempDir(), "users.db"))
+	require.NoError(t, err)
+	defer store.Close()
+	seedTestStores(t, &storeSet{users: store, posts: store, comments: store})
+
+	alice, err := store.GetUser(ctx, "1")
+	require.NoError(t, err)
+	alice.Name = "Alice Batch"
+	// Strings must be valid UTF-8, so this user can't be encoded
+	broken := &service.User{Id: "2", Name: "Bob \xff"}
+
+	err = store.SaveUsers(ctx, []*service.User{alice, broken})
+	require.Error(t, err)
+
+	alice, err = store.GetUser(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Johnson", alice.Name)
+
+	alice.Name = "Alice Batch"
+	bob, err := store.GetUser(ctx, "2")
+	require.NoError(t, err)
+	bob.Name = "Bob Batch"
+	require.NoError(t, store.SaveUsers(ctx, []*service.User{alice, bob}))
+
+	users, err := store.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Batch", users[0].Name)
+	assert.Equal(t, "Bob Batch", users[1].Name)
+}
+
+func TestBoltStoreSaveOverCorruptRecord(t *testing.T) {
+	ctx := context.Background()
+	store, err := OpenBoltStore(filepath.Join(t.TempDir(), "users.db"))
+	require.NoError(t, err)
+	defer store.Close()
+	seedTestStores(t, &storeSet{users: store, posts: store, comments: store})
+
+	// Saves must not mistake a record they can't decode for a new one
+	require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(postsBucket).Put([]byte("1"), []byte{0xff}); err != nil {
+			return err
+		}
+		return tx.Bucket(commentsBucket).Put([]byte("1"), []byte{0xff})
+	}))
+	err = store.SavePost(ctx, &service.Post{Id: "1", Title: "Replaced", AuthorId: "2"})
+	assert.ErrorIs(t, err, ErrCorrupt)
+	err = store.SaveComment(ctx, &service.Comment{Id: "1", Content: "Replaced", AuthorId: "1", PostId: "3"})
+	assert.ErrorIs(t, err, ErrCorrupt)
+
+	// The activity index is left as it was
+	activity, err := store.ListUserActivity(ctx, "2")
+	require.NoError(t, err)
+	for _, item := range activity {
+		assert.NotEqual(t, "1", item.GetPost().GetId())
+	}
+}
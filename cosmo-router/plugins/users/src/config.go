@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"fmt"
+)
+
+// Supported values for the USERS_STORE environment variable
+const (
+	storeBackendMemory = "memory"
+	storeBackendBolt   = "bolt"
+)
+
+// Supported values for the USERS_ID_SCHEME environment variable
+const (
+	idSchemeSequence = "sequence"
+	idSchemeULID     = "ulid"
+	idSchemeUUIDv7   = "uuidv7"
+)
+
+// Supported values for the USERS_DELETE_POLICY environment variable
+const (
+	deleteModeReject   = "reject"
+	deleteModeCascade  = "cascade"
+	deleteModeReassign = "reassign"
+)
+
+// DeletePolicy decides what happens to the posts and comments of a deleted user
+type DeletePolicy struct {
+	// Mode is "reject" (refuse to delete users that still have posts or
+	// comments), "cascade" (delete them together with the user) or "reassign"
+	// (hand them over to ReassignTo). An empty mode rejects.
+	Mode string
+	// ReassignTo is the ID of the user that inherits posts and comments in reassign mode
+	ReassignTo string
+}
+
+// Config holds the plugin configuration.
+// The router starts the plugin as a child process, so the configuration is
+// read from the environment the router was started with.
+type Config struct {
+	// StoreBackend selects the storage backend, either "memory" or "bolt"
+	StoreBackend string
+	// StorePath is the database file used by the bolt backend
+	StorePath string
+	// SeedFile is a JSON or YAML fixture used to seed an empty store.
+	// The embedded demo data is used when empty.
+	SeedFile string
+	// IDScheme selects how IDs of new records are generated: "sequence"
+	// (numbers persisted with the store), "ulid" or "uuidv7"
+	IDScheme string
+	// DeletePolicy applies to the posts and comments of users removed by deleteUser
+	DeletePolicy DeletePolicy
+}
+
+// loadConfig reads the plugin configuration using the given lookup function
+// (usually os.LookupEnv) and applies defaults for unset values.
+func loadConfig(lookup func(string) (string, bool)) (*Config, error) {
+	cfg := &Config{
+		StoreBackend: storeBackendMemory,
+		StorePath:    "users.db",
+		IDScheme:     idSchemeSequence,
+		DeletePolicy: DeletePolicy{Mode: deleteModeReject},
+	}
+
+	if v, ok := lookup("USERS_STORE"); ok && v != "" {
+		cfg.StoreBackend = v
+	}
+	if v, ok := lookup("USERS_STORE_PATH"); ok && v != "" {
+		cfg.StorePath = v
+	}
+	if v, ok := lookup("USERS_SEED_FILE"); ok {
+		cfg.SeedFile = v
+	}
+	if v, ok := lookup("USERS_ID_SCHEME"); ok && v != "" {
+		cfg.IDScheme = v
+	}
+	if v, ok := lookup("USERS_DELETE_POLICY"); ok && v != "" {
+		cfg.DeletePolicy.Mode = v
+	}
+	if v, ok := lookup("USERS_DELETE_REASSIGN_TO"); ok {
+		cfg.DeletePolicy.ReassignTo = v
+	}
+
+	switch cfg.StoreBackend {
+	case storeBackendMemory, storeBackendBolt:
+	default:
+		return nil, fmt.Errorf("unsupported USERS_STORE %q, expected %q or %q", cfg.StoreBackend, storeBackendMemory, storeBackendBolt)
+	}
+
+	switch cfg.IDScheme {
+	case idSchemeSequence, idSchemeULID, idSchemeUUIDv7:
+	default:
+		return nil, fmt.Errorf("unsupported USERS_ID_SCHEME %q, expected %q, %q or %q", cfg.IDScheme, idSchemeSequence, idSchemeULID, idSchemeUUIDv7)
+	}
+
+	switch cfg.DeletePolicy.Mode {
+	case deleteModeReject, deleteModeCascade:
+	case deleteModeReassign:
+		if cfg.DeletePolicy.ReassignTo == "" {
+			return nil, fmt.Errorf("USERS_DELETE_POLICY %q requires USERS_DELETE_REASSIGN_TO", deleteModeReassign)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported USERS_DELETE_POLICY %q, expected %q, %q or %q", cfg.DeletePolicy.Mode, deleteModeReject, deleteModeCascade, deleteModeReassign)
+	}
+
+	return cfg, nil
+}
+
+// storeSet bundles the stores backing a UsersService
+type storeSet struct {
+	users    UserStore
+	posts    PostStore
+	comments CommentStore
+	audit    AuditStore
+	ids      IDGenerator
+	close    func() error
+}
+
+// openStore creates the stores and the ID generator selected by the configuration
+func openStore(cfg *Config) (*storeSet, error) {
+	var stores *storeSet
+	var sequence IDGenerator
+	switch cfg.StoreBackend {
+	case storeBackendBolt:
+		store, err := OpenBoltStore(cfg.StorePath)
+		if err != nil {
+			return nil, err
+		}
+		stores = &storeSet{users: store, posts: store, comments: store, audit: store, close: store.Close}
+		sequence = store
+	default:
+		store := NewMemoryStore()
+		stores = &storeSet{users: store, posts: store, comments: store, audit: store, close: func() error { return nil }}
+		sequence = store
+	}
+
+	switch cfg.IDScheme {
+	case idSchemeULID:
+		stores.ids = ulidGenerator{}
+	case idSchemeUUIDv7:
+		stores.ids = uuidV7Generator{}
+	default:
+		stores.ids = sequence
+	}
+	return stores, nil
+}
+
+// seedIfEmpty populates the stores with the fixture unless they already contain users
+func seedIfEmpty(ctx context.Context, stores *storeSet, fixture *seedFixture) error {
+	// Deleted users count, so a store whose users have all been deleted isn't seeded again
+	users, err := stores.users.ListUsers(withDeleted(ctx))
+	if err != nil {
+		return err
+	}
+	if len(users) > 0 {
+		return nil
+	}
+	return fixture.seed(ctx, stores.users, stores.posts, stores.comments)
+}
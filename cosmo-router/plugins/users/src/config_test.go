@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    *Config
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			env:  map[string]string{},
+			want: &Config{StoreBackend: storeBackendMemory, StorePath: "users.db", IDScheme: idSchemeSequence, DeletePolicy: DeletePolicy{Mode: deleteModeReject}},
+		},
+		{
+			name: "bolt backend",
+			env:  map[string]string{"USERS_STORE": "bolt", "USERS_STORE_PATH": "/tmp/data.db", "USERS_SEED_FILE": "seed.yaml"},
+			want: &Config{StoreBackend: storeBackendBolt, StorePath: "/tmp/data.db", SeedFile: "seed.yaml", IDScheme: idSchemeSequence, DeletePolicy: DeletePolicy{Mode: deleteModeReject}},
+		},
+		{
+			name: "reassign delete policy",
+			env:  map[string]string{"USERS_DELETE_POLICY": "reassign", "USERS_DELETE_REASSIGN_TO": "1"},
+			want: &Config{StoreBackend: storeBackendMemory, StorePath: "users.db", IDScheme: idSchemeSequence, DeletePolicy: DeletePolicy{Mode: deleteModeReassign, ReassignTo: "1"}},
+		},
+		{
+			name: "ulid ID scheme",
+			env:  map[string]string{"USERS_ID_SCHEME": "ulid"},
+			want: &Config{StoreBackend: storeBackendMemory, StorePath: "users.db", IDScheme: idSchemeULID, DeletePolicy: DeletePolicy{Mode: deleteModeReject}},
+		},
+		{
+			name:    "unknown ID scheme",
+			env:     map[string]string{"USERS_ID_SCHEME": "random"},
+			wantErr: true,
+		},
+		{
+			name:    "reassign delete policy without target",
+			env:     map[string]string{"USERS_DELETE_POLICY": "reassign"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown delete policy",
+			env:     map[string]string{"USERS_DELETE_POLICY": "orphan"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown backend",
+			env:     map[string]string{"USERS_STORE": "postgres"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := loadConfig(func(key string) (string, bool) {
+				v, ok := tt.env[key]
+				return v, ok
+			})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, cfg)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// The RPCs of UsersService report failures as gRPC status errors, which the
+// router turns into GraphQL errors:
+//
+//   - InvalidArgument for invalid arguments, with a BadRequest detail that
+//     lists every invalid field
+//   - NotFound for unknown IDs, with a ResourceInfo detail naming the resource
+//   - PermissionDenied for requests the actor of the request may not make, with
+//     an ErrorInfo detail
+//   - FailedPrecondition for requests the current state doesn't allow, with a
+//     PreconditionFailure detail
+//   - Aborted for updates based on an outdated version of a user, with an
+//     ErrorInfo detail; they may succeed when the user is reloaded and retried
+//   - Unavailable for failures of the store or the external API, which may
+//     succeed when retried
+//   - Internal for failures that a retry won't fix, like corrupt records in
+//     the store or bugs in the plugin
+//
+// Requests that are canceled or time out report Canceled or DeadlineExceeded.
+
+// Resource types reported in the ResourceInfo of NotFound errors
+const (
+	resourceUser         = "User"
+	resourceExternalUser = "ExternalUser"
+	resourcePost         = "Post"
+	resourceComment      = "Comment"
+)
+
+// invalidArgument returns an InvalidArgument status error that lists every
+// invalid field as a field violation. Field paths are relative to the request
+// and start with prefix, e.g. "input." or "input[2].".
+func invalidArgument(prefix string, errs []*fieldError) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(errs))
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       prefix + err.Field,
+			Description: err.Message,
+		})
+		messages = append(messages, prefix+err.Error())
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid input: "+strings.Join(messages, "; "))
+	return withDetails(st, &errdetails.BadRequest{FieldViolations: violations})
+}
+
+// invalidArgumentError returns an InvalidArgument status error for an error
+// returned by an argument parser. A *fieldError names the invalid argument.
+func invalidArgumentError(err error) error {
+	var fieldErr *fieldError
+	if errors.As(err, &fieldErr) {
+		return invalidArgument("", []*fieldError{fieldErr})
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// notFound returns a NotFound status error for the resource of the given type and ID
+func notFound(resourceType, id string) error {
+	st := status.New(codes.NotFound, fmt.Sprintf("%s %q not found", strings.ToLower(resourceType), id))
+	return withDetails(st, &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: id,
+		Description:  fmt.Sprintf("no %s with ID %q exists", resourceType, id),
+	})
+}
+
+// failedPrecondition returns a FailedPrecondition status error for a request
+// on subject that the current state doesn't allow, e.g. subject "user/1"
+func failedPrecondition(violationType, subject, message string) error {
+	st := status.New(codes.FailedPrecondition, message)
+	return withDetails(st, &errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{
+		{Type: violationType, Subject: subject, Description: message},
+	}})
+}
+
+// errorDomain is the domain of the ErrorInfo details of the plugin
+const errorDomain = "users.plugin"
+
+// permissionDenied returns a PermissionDenied status error for a request that
+// the actor of the request may not make, for the given reason, e.g. "NOT_AUTHOR"
+func permissionDenied(reason, message string) error {
+	st := status.New(codes.PermissionDenied, message)
+	return withDetails(st, &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain})
+}
+
+// versionConflict returns an Aborted status error for an update of the user
+// with the given ID that expected another version than the current one
+func versionConflict(id string, expected, current int32) error {
+	st := status.New(codes.Aborted, versionConflictMessage(id, expected, current))
+	return withDetails(st, &errdetails.ErrorInfo{
+		Reason: "VERSION_CONFLICT",
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"id":              id,
+			"expectedVersion": strconv.Itoa(int(expected)),
+			"currentVersion":  strconv.Itoa(int(current)),
+		},
+	})
+}
+
+// concurrentUpdate returns an Aborted status error for a save that failed with
+// ErrVersionConflict, because another request changed the user in between
+func concurrentUpdate(err error) error {
+	st := status.New(codes.Aborted, err.Error())
+	return withDetails(st, &errdetails.ErrorInfo{Reason: "VERSION_CONFLICT", Domain: errorDomain})
+}
+
+// versionConflictMessage describes a version conflict
+func versionConflictMessage(id string, expected, current int32) string {
+	return fmt.Sprintf("user %q has been changed: expected version %d, but it is at version %d", id, expected, current)
+}
+
+// unavailable returns an Unavailable status error for a failed call to the
+// store or the external API. Errors that already carry a status code keep it,
+// canceled or timed out requests report Canceled or DeadlineExceeded, and
+// corrupt records report Internal, because retrying won't help.
+func unavailable(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if st := status.FromContextError(err); st.Code() != codes.Unknown {
+		return st.Err()
+	}
+	if errors.Is(err, ErrCorrupt) {
+		return internal(err)
+	}
+	return status.Error(codes.Unavailable, err.Error())
+}
+
+// internal returns an Internal status error for a failure that retrying won't
+// fix. Errors that already carry a status code keep it.
+func internal(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// withDetails attaches details to st and returns it as an error. The status is
+// returned without details if they can't be encoded.
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	if detailed, err := st.WithDetails(details...); err == nil {
+		return detailed.Err()
+	}
+	return st.Err()
+}
@@ -0,0 +1,565 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"github.com/wundergraph/cosmo/router-plugin/httpclient"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// rpcCall calls a single RPC of the users service and returns its error
+type rpcCall func(ctx context.Context, client service.UsersServiceClient) error
+
+// errorDetail returns the detail of type T attached to a status error
+func errorDetail[T any](t *testing.T, err error) T {
+	t.Helper()
+	for _, detail := range status.Convert(err).Details() {
+		if d, ok := detail.(T); ok {
+			return d
+		}
+	}
+	var zero T
+	t.Fatalf("error %v has no %T detail", err, zero)
+	return zero
+}
+
+func TestErrorCodes(t *testing.T) {
+	svc, _ := setupExternalTestService(t)
+	defer svc.cleanup()
+
+	tests := []struct {
+		name  string
+		call  rpcCall
+		code  codes.Code
+		check func(t *testing.T, err error)
+	}{
+		{
+			name: "LookupUserById returns placeholders for unknown users",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.LookupUserById(ctx, &service.LookupUserByIdRequest{Keys: []*service.LookupUserByIdRequestKey{{Id: "999"}}})
+				return err
+			},
+			code: codes.OK,
+		},
+		{
+			name: "LookupPostById returns placeholders for unknown posts",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.LookupPostById(ctx, &service.LookupPostByIdRequest{Keys: []*service.LookupPostByIdRequestKey{{Id: "999"}}})
+				return err
+			},
+			code: codes.OK,
+		},
+		{
+			name: "LookupCommentById returns placeholders for unknown comments",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.LookupCommentById(ctx, &service.LookupCommentByIdRequest{Keys: []*service.LookupCommentByIdRequestKey{{Id: "999"}}})
+				return err
+			},
+			code: codes.OK,
+		},
+		{
+			name: "QueryUser with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryUser(ctx, &service.QueryUserRequest{Id: "999"})
+				return err
+			},
+			code: codes.NotFound,
+			check: func(t *testing.T, err error) {
+				info := errorDetail[*errdetails.ResourceInfo](t, err)
+				assert.Equal(t, "User", info.ResourceType)
+				assert.Equal(t, "999", info.ResourceName)
+				assert.Equal(t, `user "999" not found`, status.Convert(err).Message())
+			},
+		},
+		{
+			name: "QueryUsers with incomplete orderBy",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryUsers(ctx, &service.QueryUsersRequest{OrderBy: &service.ListOfUserOrder{List: &service.ListOfUserOrder_List{Items: []*service.UserOrder{{}}}}})
+				return err
+			},
+			code: codes.InvalidArgument,
+			check: func(t *testing.T, err error) {
+				assert.Contains(t, fieldViolations(t, err), "orderBy[0].field")
+			},
+		},
+		{
+			name: "QueryUsersConnection with first and last",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{First: wrapperspb.Int32(1), Last: wrapperspb.Int32(1)})
+				return err
+			},
+			code: codes.InvalidArgument,
+			check: func(t *testing.T, err error) {
+				assert.Contains(t, fieldViolations(t, err), "last")
+			},
+		},
+		{
+			name: "QueryUsersConnection with malformed cursor",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{After: wrapperspb.String("not a cursor")})
+				return err
+			},
+			code: codes.InvalidArgument,
+			check: func(t *testing.T, err error) {
+				assert.Contains(t, fieldViolations(t, err), "after")
+			},
+		},
+		{
+			name: "QuerySearch with negative first",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QuerySearch(ctx, &service.QuerySearchRequest{Query: "alice", First: wrapperspb.Int32(-1)})
+				return err
+			},
+			code: codes.InvalidArgument,
+			check: func(t *testing.T, err error) {
+				assert.Contains(t, fieldViolations(t, err), "first")
+			},
+		},
+		{
+			name: "QueryExternalUser with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryExternalUser(ctx, &service.QueryExternalUserRequest{Id: "999"})
+				return err
+			},
+			code: codes.NotFound,
+			check: func(t *testing.T, err error) {
+				assert.Equal(t, "ExternalUser", errorDetail[*errdetails.ResourceInfo](t, err).ResourceType)
+			},
+		},
+		{
+			name: "MutationUpdateUser with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: &service.UserInput{Id: "999", Name: wrapperspb.String("Nobody")}})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "MutationUpdateUser with invalid input",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: &service.UserInput{Id: "1", Age: wrapperspb.Int32(-1)}})
+				return err
+			},
+			code: codes.InvalidArgument,
+			check: func(t *testing.T, err error) {
+				assert.Contains(t, fieldViolations(t, err), "input.age")
+			},
+		},
+		{
+			name: "MutationUpdateUser with outdated expected version",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: &service.UserInput{Id: "1", ExpectedVersion: wrapperspb.Int32(7)}})
+				return err
+			},
+			code: codes.Aborted,
+			check: func(t *testing.T, err error) {
+				assert.Equal(t, "VERSION_CONFLICT", errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+			},
+		},
+		{
+			name: "MutationUpdateUsers reports unknown IDs per input",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{Input: []*service.UserInput{{Id: "999"}}})
+				return err
+			},
+			code: codes.OK,
+		},
+		{
+			name: "MutationCreateUser without input",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationCreateUser(ctx, &service.MutationCreateUserRequest{})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "MutationCreatePost with unknown author",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationCreatePost(ctx, &service.MutationCreatePostRequest{Input: &service.PostInput{Title: "Hello", AuthorId: "999"}})
+				return err
+			},
+			code: codes.NotFound,
+			check: func(t *testing.T, err error) {
+				info := errorDetail[*errdetails.ResourceInfo](t, err)
+				assert.Equal(t, "User", info.ResourceType)
+				assert.Equal(t, "999", info.ResourceName)
+			},
+		},
+		{
+			name: "MutationCreatePost with empty title",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationCreatePost(ctx, &service.MutationCreatePostRequest{Input: &service.PostInput{AuthorId: "1"}})
+				return err
+			},
+			code: codes.InvalidArgument,
+			check: func(t *testing.T, err error) {
+				assert.Contains(t, fieldViolations(t, err), "input.title")
+			},
+		},
+		{
+			name: "QueryPost with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryPost(ctx, &service.QueryPostRequest{Id: "999"})
+				return err
+			},
+			code: codes.NotFound,
+			check: func(t *testing.T, err error) {
+				assert.Equal(t, "Post", errorDetail[*errdetails.ResourceInfo](t, err).ResourceType)
+			},
+		},
+		{
+			name: "QueryPosts with an invalid cursor",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryPosts(ctx, &service.QueryPostsRequest{After: wrapperspb.String("not a cursor")})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "MutationUpdatePost with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationUpdatePost(ctx, &service.MutationUpdatePostRequest{Input: &service.UpdatePostInput{Id: "999", Title: wrapperspb.String("Hello")}})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "MutationUpdatePost with empty title",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationUpdatePost(ctx, &service.MutationUpdatePostRequest{Input: &service.UpdatePostInput{Id: "1", Title: wrapperspb.String("")}})
+				return err
+			},
+			code: codes.InvalidArgument,
+			check: func(t *testing.T, err error) {
+				assert.Contains(t, fieldViolations(t, err), "input.title")
+			},
+		},
+		{
+			name: "MutationUpdatePost of another user's post",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationUpdatePost(ctx, &service.MutationUpdatePostRequest{Input: &service.UpdatePostInput{Id: "1", Title: wrapperspb.String("Hello")}})
+				return err
+			},
+			code: codes.PermissionDenied,
+			check: func(t *testing.T, err error) {
+				info := errorDetail[*errdetails.ErrorInfo](t, err)
+				assert.Equal(t, "NOT_AUTHOR", info.Reason)
+				assert.Equal(t, errorDomain, info.Domain)
+			},
+		},
+		{
+			name: "MutationDeletePost with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationDeletePost(ctx, &service.MutationDeletePostRequest{Id: "999"})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "MutationCreateComment on unknown post",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationCreateComment(ctx, &service.MutationCreateCommentRequest{Input: &service.CommentInput{PostId: "999", AuthorId: "1", Content: "Hello"}})
+				return err
+			},
+			code: codes.NotFound,
+			check: func(t *testing.T, err error) {
+				info := errorDetail[*errdetails.ResourceInfo](t, err)
+				assert.Equal(t, "Post", info.ResourceType)
+				assert.Equal(t, "999", info.ResourceName)
+			},
+		},
+		{
+			name: "MutationCreateComment without content",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationCreateComment(ctx, &service.MutationCreateCommentRequest{Input: &service.CommentInput{PostId: "1", AuthorId: "1"}})
+				return err
+			},
+			code: codes.InvalidArgument,
+			check: func(t *testing.T, err error) {
+				assert.Contains(t, fieldViolations(t, err), "input.content")
+			},
+		},
+		{
+			name: "MutationUpdateComment with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationUpdateComment(ctx, &service.MutationUpdateCommentRequest{Input: &service.UpdateCommentInput{Id: "999", Content: "Hello"}})
+				return err
+			},
+			code: codes.NotFound,
+			check: func(t *testing.T, err error) {
+				assert.Equal(t, "Comment", errorDetail[*errdetails.ResourceInfo](t, err).ResourceType)
+			},
+		},
+		{
+			name: "MutationDeleteComment with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationDeleteComment(ctx, &service.MutationDeleteCommentRequest{Id: "999"})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "MutationDeleteUser with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationDeleteUser(ctx, &service.MutationDeleteUserRequest{Id: "999"})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "MutationDeleteUser of a user with activity",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationDeleteUser(ctx, &service.MutationDeleteUserRequest{Id: "1"})
+				return err
+			},
+			code: codes.FailedPrecondition,
+			check: func(t *testing.T, err error) {
+				failure := errorDetail[*errdetails.PreconditionFailure](t, err)
+				require.Len(t, failure.Violations, 1)
+				assert.Equal(t, "ACTIVITY", failure.Violations[0].Type)
+				assert.Equal(t, "user/1", failure.Violations[0].Subject)
+			},
+		},
+		{
+			name: "MutationRestoreUser with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.MutationRestoreUser(ctx, &service.MutationRestoreUserRequest{Id: "999"})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "QueryUserActivity with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryUserActivity(ctx, &service.QueryUserActivityRequest{UserId: "999"})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "QueryUserActivity with negative limit",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryUserActivity(ctx, &service.QueryUserActivityRequest{UserId: "1", Limit: wrapperspb.Int32(-1)})
+				return err
+			},
+			code: codes.InvalidArgument,
+			check: func(t *testing.T, err error) {
+				assert.Equal(t, "must not be negative, got -1", fieldViolations(t, err)["limit"])
+			},
+		},
+		{
+			name: "QueryUserActivity with includeDeleted by a non-admin",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryUserActivity(ctx, &service.QueryUserActivityRequest{UserId: "1", IncludeDeleted: wrapperspb.Bool(true)})
+				return err
+			},
+			code: codes.PermissionDenied,
+			check: func(t *testing.T, err error) {
+				assert.Equal(t, "ADMIN_REQUIRED", errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+			},
+		},
+		{
+			name: "QueryUserActivity with malformed since",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryUserActivity(ctx, &service.QueryUserActivityRequest{UserId: "1", Since: wrapperspb.String("last week")})
+				return err
+			},
+			code: codes.InvalidArgument,
+			check: func(t *testing.T, err error) {
+				assert.Contains(t, fieldViolations(t, err), "since")
+			},
+		},
+		{
+			name: "QueryUserActivityConnection with unknown ID",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryUserActivityConnection(ctx, &service.QueryUserActivityConnectionRequest{UserId: "999"})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "QueryUserHistory with malformed cursor",
+			call: func(ctx context.Context, c service.UsersServiceClient) error {
+				_, err := c.QueryUserHistory(ctx, &service.QueryUserHistoryRequest{UserId: "1", After: wrapperspb.String("???")})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call(context.Background(), svc.usersClient)
+			require.Equal(t, tt.code, status.Code(err), "error: %v", err)
+			if tt.check != nil {
+				tt.check(t, err)
+			}
+		})
+	}
+}
+
+func TestErrorCodesUnavailable(t *testing.T) {
+	// A closed bolt store fails every operation
+	stores, err := openStore(&Config{StoreBackend: storeBackendBolt, StorePath: filepath.Join(t.TempDir(), "users.db")})
+	require.NoError(t, err)
+	seedTestStores(t, stores)
+	svc := setupTestServiceWithStores(t, stores)
+	defer svc.cleanup()
+	require.NoError(t, stores.close())
+
+	calls := map[string]rpcCall{
+		"LookupPostById": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.LookupPostById(ctx, &service.LookupPostByIdRequest{Keys: []*service.LookupPostByIdRequestKey{{Id: "1"}}})
+			return err
+		},
+		"LookupCommentById": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.LookupCommentById(ctx, &service.LookupCommentByIdRequest{Keys: []*service.LookupCommentByIdRequestKey{{Id: "1"}}})
+			return err
+		},
+		"LookupUserById": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.LookupUserById(ctx, &service.LookupUserByIdRequest{Keys: []*service.LookupUserByIdRequestKey{{Id: "1"}}})
+			return err
+		},
+		"QueryUser": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.QueryUser(ctx, &service.QueryUserRequest{Id: "1"})
+			return err
+		},
+		"QueryUsers": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.QueryUsers(ctx, &service.QueryUsersRequest{})
+			return err
+		},
+		"QueryUsersConnection": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{})
+			return err
+		},
+		"QuerySearch": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.QuerySearch(ctx, &service.QuerySearchRequest{Query: "alice"})
+			return err
+		},
+		"MutationUpdateUser": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: &service.UserInput{Id: "1"}})
+			return err
+		},
+		"MutationUpdateUsers": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{Input: []*service.UserInput{{Id: "1"}}})
+			return err
+		},
+		"MutationCreateUser": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.MutationCreateUser(ctx, &service.MutationCreateUserRequest{Input: &service.CreateUserInput{Name: "Dana", Email: "dana@example.com"}})
+			return err
+		},
+		"MutationCreatePost": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.MutationCreatePost(ctx, &service.MutationCreatePostRequest{Input: &service.PostInput{Title: "Hello", AuthorId: "1"}})
+			return err
+		},
+		"MutationDeleteUser": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.MutationDeleteUser(ctx, &service.MutationDeleteUserRequest{Id: "1"})
+			return err
+		},
+		"MutationRestoreUser": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.MutationRestoreUser(ctx, &service.MutationRestoreUserRequest{Id: "1"})
+			return err
+		},
+		"MutationCreateComment": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.MutationCreateComment(ctx, &service.MutationCreateCommentRequest{Input: &service.CommentInput{PostId: "1", AuthorId: "1", Content: "Hello"}})
+			return err
+		},
+		"MutationUpdateComment": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.MutationUpdateComment(ctx, &service.MutationUpdateCommentRequest{Input: &service.UpdateCommentInput{Id: "1", Content: "Hello"}})
+			return err
+		},
+		"MutationDeleteComment": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.MutationDeleteComment(ctx, &service.MutationDeleteCommentRequest{Id: "1"})
+			return err
+		},
+		"MutationUpdatePost": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.MutationUpdatePost(ctx, &service.MutationUpdatePostRequest{Input: &service.UpdatePostInput{Id: "1", Title: wrapperspb.String("Hello")}})
+			return err
+		},
+		"MutationDeletePost": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.MutationDeletePost(ctx, &service.MutationDeletePostRequest{Id: "1"})
+			return err
+		},
+		"QueryPost": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.QueryPost(ctx, &service.QueryPostRequest{Id: "1"})
+			return err
+		},
+		"QueryPosts": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.QueryPosts(ctx, &service.QueryPostsRequest{})
+			return err
+		},
+		"QueryUserActivity": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.QueryUserActivity(ctx, &service.QueryUserActivityRequest{UserId: "1"})
+			return err
+		},
+		"QueryUserActivityConnection": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.QueryUserActivityConnection(ctx, &service.QueryUserActivityConnectionRequest{UserId: "1"})
+			return err
+		},
+		"QueryUserHistory": func(ctx context.Context, c service.UsersServiceClient) error {
+			_, err := c.QueryUserHistory(ctx, &service.QueryUserHistoryRequest{UserId: "1"})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			err := call(context.Background(), svc.usersClient)
+			assert.Equal(t, codes.Unavailable, status.Code(err), "error: %v", err)
+		})
+	}
+}
+
+func TestErrorCodesExternalAPIUnavailable(t *testing.T) {
+	svc, mockServer := setupExternalTestService(t)
+	defer svc.cleanup()
+	mockServer.Close()
+
+	// Fail fast instead of retrying the closed server
+	httpClient = httpclient.New(
+		httpclient.WithBaseURL(mockServer.URL),
+		httpclient.WithRetry(httpclient.RetryOptions{Enabled: false}),
+	)
+
+	_, err := svc.usersClient.QueryExternalUsers(context.Background(), &service.QueryExternalUsersRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err), "error: %v", err)
+
+	_, err = svc.usersClient.QueryExternalUser(context.Background(), &service.QueryExternalUserRequest{Id: "1"})
+	assert.Equal(t, codes.Unavailable, status.Code(err), "error: %v", err)
+}
+
+func TestUnavailable(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.Equal(t, codes.Canceled, status.Code(unavailable(ctx.Err())))
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(unavailable(context.DeadlineExceeded)))
+	assert.Equal(t, codes.NotFound, status.Code(unavailable(notFound(resourceUser, "1"))))
+	assert.Equal(t, codes.Unavailable, status.Code(unavailable(ErrNotFound)))
+	assert.Equal(t, codes.Internal, status.Code(unavailable(fmt.Errorf("failed to get user 1: %w", ErrCorrupt))))
+}
+
+func TestErrorCodesCorruptRecord(t *testing.T) {
+	stores, err := openStore(&Config{StoreBackend: storeBackendBolt, StorePath: filepath.Join(t.TempDir(), "users.db")})
+	require.NoError(t, err)
+	seedTestStores(t, stores)
+	svc := setupTestServiceWithStores(t, stores)
+	defer svc.cleanup()
+
+	// A record that can't be decoded fails the same way on every retry
+	require.NoError(t, stores.users.(*BoltStore).db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(usersBucket).Put([]byte("1"), []byte{0xff})
+	}))
+	_, err = svc.usersClient.QueryUser(context.Background(), &service.QueryUserRequest{Id: "1"})
+	assert.Equal(t, codes.Internal, status.Code(err), "error: %v", err)
+}
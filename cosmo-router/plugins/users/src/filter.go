@@ -0,0 +1,124 @@
+package main
+
+import (
+	"slices"
+	"strings"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+)
+
+// filterUsers returns the users matching filter, keeping their order.
+// A nil filter matches all users.
+func filterUsers(users []*service.User, filter *service.UserFilter) []*service.User {
+	if filter == nil {
+		return users
+	}
+	return slices.DeleteFunc(users, func(user *service.User) bool {
+		return !matchUserFilter(filter, user)
+	})
+}
+
+// matchUserFilter reports whether user matches all conditions set in filter.
+// Lists are wrapped so that an empty list can be told apart from a missing one:
+// an empty role_in, tags_contains_any or OR matches no user, while an empty
+// permissions_contains_all or AND matches every user.
+func matchUserFilter(filter *service.UserFilter, user *service.User) bool {
+	if filter.RoleIn != nil && !slices.Contains(filter.RoleIn.GetList().GetItems(), user.Role) {
+		return false
+	}
+
+	if filter.TagsContainsAny != nil {
+		tags := user.Tags.GetList().GetItems()
+		matched := slices.ContainsFunc(filter.TagsContainsAny.GetList().GetItems(), func(tag string) bool {
+			return slices.Contains(tags, tag)
+		})
+		if !matched {
+			return false
+		}
+	}
+
+	if filter.PermissionsContainsAll != nil {
+		for _, permission := range filter.PermissionsContainsAll.GetList().GetItems() {
+			if !slices.Contains(user.Permissions, permission) {
+				return false
+			}
+		}
+	}
+
+	if filter.Age != nil && !matchIntRange(filter.Age, user.Age.GetValue(), user.Age != nil) {
+		return false
+	}
+
+	if filter.Skill != nil && !hasSkill(user, filter.Skill.Value) {
+		return false
+	}
+
+	if filter.And != nil {
+		for _, f := range filter.And.GetList().GetItems() {
+			if !matchUserFilter(f, user) {
+				return false
+			}
+		}
+	}
+
+	if filter.Or != nil {
+		matched := slices.ContainsFunc(filter.Or.GetList().GetItems(), func(f *service.UserFilter) bool {
+			return matchUserFilter(f, user)
+		})
+		if !matched {
+			return false
+		}
+	}
+
+	if filter.Not != nil && matchUserFilter(filter.Not, user) {
+		return false
+	}
+
+	return true
+}
+
+// filterPosts returns the posts matching filter, keeping their order.
+// A nil filter matches all posts, and an empty status_in matches none.
+func filterPosts(posts []*service.Post, filter *service.PostFilter) []*service.Post {
+	if filter == nil {
+		return posts
+	}
+	return slices.DeleteFunc(posts, func(post *service.Post) bool {
+		if filter.AuthorId != nil && post.AuthorId != filter.AuthorId.Value {
+			return true
+		}
+		return filter.StatusIn != nil && !slices.Contains(filter.StatusIn.GetList().GetItems(), post.Status)
+	})
+}
+
+// matchIntRange reports whether value lies within r. Missing values never match.
+func matchIntRange(r *service.IntRange, value int32, present bool) bool {
+	if !present {
+		return false
+	}
+	if r.Gt != nil && value <= r.Gt.Value {
+		return false
+	}
+	if r.Gte != nil && value < r.Gte.Value {
+		return false
+	}
+	if r.Lt != nil && value >= r.Lt.Value {
+		return false
+	}
+	if r.Lte != nil && value > r.Lte.Value {
+		return false
+	}
+	return true
+}
+
+// hasSkill reports whether the user lists skill in any of their skill categories
+func hasSkill(user *service.User, skill string) bool {
+	for _, category := range user.SkillCategories.GetList().GetItems() {
+		for _, s := range category.GetList().GetItems() {
+			if strings.EqualFold(s, skill) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultFixture holds the demo data used when no USERS_SEED_FILE is configured
+//
+//go:embed fixtures/seed.json
+var defaultFixture []byte
+
+// seedFixture describes the seed data of the plugin.
+// Field names follow the GraphQL schema, so a fixture reads like a query result.
+// Posts and comments are listed oldest first; the activity of each user is
+// derived from them by the store. Posts and comments without createdAt are
+// created when the store is seeded.
+type seedFixture struct {
+	Users    []fixtureUser    `json:"users" yaml:"users"`
+	Posts    []fixturePost    `json:"posts" yaml:"posts"`
+	Comments []fixtureComment `json:"comments" yaml:"comments"`
+}
+
+// fixtureUser is the fixture representation of a User
+type fixtureUser struct {
+	ID              string          `json:"id" yaml:"id"`
+	Name            string          `json:"name" yaml:"name"`
+	Email           string          `json:"email" yaml:"email"`
+	Role            string          `json:"role" yaml:"role"`
+	Permissions     []string        `json:"permissions" yaml:"permissions"`
+	Tags            []string        `json:"tags" yaml:"tags"`
+	SkillCategories [][]string      `json:"skillCategories" yaml:"skillCategories"`
+	Profile         *fixtureProfile `json:"profile" yaml:"profile"`
+	Bio             *string         `json:"bio" yaml:"bio"`
+	Age             *int32          `json:"age" yaml:"age"`
+}
+
+// fixtureProfile is the fixture representation of a Profile
+type fixtureProfile struct {
+	DisplayName *string `json:"displayName" yaml:"displayName"`
+	Timezone    *string `json:"timezone" yaml:"timezone"`
+	Theme       string  `json:"theme" yaml:"theme"`
+}
+
+// fixturePost is the fixture representation of a Post
+type fixturePost struct {
+	ID        string `json:"id" yaml:"id"`
+	Title     string `json:"title" yaml:"title"`
+	Status    string `json:"status" yaml:"status"`
+	AuthorID  string `json:"authorId" yaml:"authorId"`
+	CreatedAt string `json:"createdAt" yaml:"createdAt"`
+}
+
+// fixtureComment is the fixture representation of a Comment
+type fixtureComment struct {
+	ID        string `json:"id" yaml:"id"`
+	Content   string `json:"content" yaml:"content"`
+	AuthorID  string `json:"authorId" yaml:"authorId"`
+	PostID    string `json:"postId" yaml:"postId"`
+	CreatedAt string `json:"createdAt" yaml:"createdAt"`
+}
+
+// loadFixture reads and validates the fixture at path.
+// An empty path loads the embedded demo data. Files ending in .yaml or .yml
+// are decoded as YAML, everything else as JSON.
+func loadFixture(path string) (*seedFixture, error) {
+	if path == "" {
+		return parseFixture(defaultFixture, false)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture %s: %w", path, err)
+	}
+
+	ext := strings.ToLower(filepath.Ext(path))
+	fixture, err := parseFixture(data, ext == ".yaml" || ext == ".yml")
+	if err != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %w", path, err)
+	}
+	return fixture, nil
+}
+
+// parseFixture decodes and validates fixture data
+func parseFixture(data []byte, isYAML bool) (*seedFixture, error) {
+	fixture := &seedFixture{}
+	if isYAML {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		// An empty document decodes into an empty fixture
+		if err := decoder.Decode(fixture); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("failed to decode fixture: %w", err)
+		}
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(fixture); err != nil {
+			return nil, fmt.Errorf("failed to decode fixture: %w", err)
+		}
+	}
+
+	if err := fixture.validate(); err != nil {
+		return nil, err
+	}
+	return fixture, nil
+}
+
+// validate checks that IDs are unique, enum values are known, creation times
+// are RFC 3339 times and that the authors of all posts and comments and the
+// posts of all comments exist.
+// All problems are reported at once.
+func (f *seedFixture) validate() error {
+	var errs []error
+
+	users := make(map[string]bool, len(f.Users))
+	for i, user := range f.Users {
+		if user.ID == "" {
+			errs = append(errs, fmt.Errorf("users[%d]: id is required", i))
+		} else if users[user.ID] {
+			errs = append(errs, fmt.Errorf("users[%d]: duplicate id %q", i, user.ID))
+		}
+		users[user.ID] = true
+	}
+
+	posts := make(map[string]bool, len(f.Posts))
+	for i, post := range f.Posts {
+		if post.ID == "" {
+			errs = append(errs, fmt.Errorf("posts[%d]: id is required", i))
+		} else if posts[post.ID] {
+			errs = append(errs, fmt.Errorf("posts[%d]: duplicate id %q", i, post.ID))
+		}
+		posts[post.ID] = true
+		if !users[post.AuthorID] {
+			errs = append(errs, fmt.Errorf("posts[%d].authorId: unknown user %q", i, post.AuthorID))
+		}
+		if _, err := parseFixtureTime(post.CreatedAt); err != nil {
+			errs = append(errs, fmt.Errorf("posts[%d].createdAt: %w", i, err))
+		}
+		if _, err := parsePostStatus(post.Status); err != nil {
+			errs = append(errs, fmt.Errorf("posts[%d].status: %w", i, err))
+		}
+	}
+
+	comments := make(map[string]bool, len(f.Comments))
+	for i, comment := range f.Comments {
+		if comment.ID == "" {
+			errs = append(errs, fmt.Errorf("comments[%d]: id is required", i))
+		} else if comments[comment.ID] {
+			errs = append(errs, fmt.Errorf("comments[%d]: duplicate id %q", i, comment.ID))
+		}
+		comments[comment.ID] = true
+		if !users[comment.AuthorID] {
+			errs = append(errs, fmt.Errorf("comments[%d].authorId: unknown user %q", i, comment.AuthorID))
+		}
+		if !posts[comment.PostID] {
+			errs = append(errs, fmt.Errorf("comments[%d].postId: unknown post %q", i, comment.PostID))
+		}
+		if _, err := parseFixtureTime(comment.CreatedAt); err != nil {
+			errs = append(errs, fmt.Errorf("comments[%d].createdAt: %w", i, err))
+		}
+	}
+
+	for i, user := range f.Users {
+		if _, err := parseUserRole(user.Role); err != nil {
+			errs = append(errs, fmt.Errorf("users[%d].role: %w", i, err))
+		}
+		if user.Profile != nil {
+			if _, err := parseTheme(user.Profile.Theme); err != nil {
+				errs = append(errs, fmt.Errorf("users[%d].profile.theme: %w", i, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// seed writes the fixture into the given stores.
+// Posts and comments are saved in fixture order, so the last entry of a user
+// becomes their most recent activity.
+func (f *seedFixture) seed(ctx context.Context, users UserStore, posts PostStore, comments CommentStore) error {
+	for _, u := range f.Users {
+		// The fixture has been validated, so enum values are known to resolve
+		role, _ := parseUserRole(u.Role)
+		user := &service.User{
+			Id:          u.ID,
+			Name:        u.Name,
+			Email:       u.Email,
+			Role:        role,
+			Permissions: u.Permissions,
+			SkillCategories: &service.ListOfListOfString{
+				List: &service.ListOfListOfString_List{Items: make([]*service.ListOfString, 0, len(u.SkillCategories))},
+			},
+		}
+		if user.Permissions == nil {
+			user.Permissions = []string{}
+		}
+		if u.Tags != nil {
+			user.Tags = &service.ListOfString{List: &service.ListOfString_List{Items: u.Tags}}
+		}
+		for _, skills := range u.SkillCategories {
+			user.SkillCategories.List.Items = append(user.SkillCategories.List.Items, &service.ListOfString{List: &service.ListOfString_List{Items: skills}})
+		}
+		if u.Profile != nil {
+			theme, _ := parseTheme(u.Profile.Theme)
+			user.Profile = &service.Profile{Theme: theme}
+			if u.Profile.DisplayName != nil {
+				user.Profile.DisplayName = &wrapperspb.StringValue{Value: *u.Profile.DisplayName}
+			}
+			if u.Profile.Timezone != nil {
+				user.Profile.Timezone = &wrapperspb.StringValue{Value: *u.Profile.Timezone}
+			}
+		}
+		if u.Bio != nil {
+			user.Bio = &wrapperspb.StringValue{Value: *u.Bio}
+		}
+		if u.Age != nil {
+			user.Age = &wrapperspb.Int32Value{Value: *u.Age}
+		}
+
+		if err := users.SaveUser(ctx, user); err != nil {
+			return fmt.Errorf("failed to seed user %s: %w", user.Id, err)
+		}
+	}
+
+	for _, p := range f.Posts {
+		createdAt, _ := parseFixtureTime(p.CreatedAt)
+		status, _ := parsePostStatus(p.Status)
+		post := &service.Post{Id: p.ID, Title: p.Title, Status: status, AuthorId: p.AuthorID, CreatedAt: createdAt}
+		if err := posts.SavePost(ctx, post); err != nil {
+			return fmt.Errorf("failed to seed post %s: %w", post.Id, err)
+		}
+	}
+
+	for _, c := range f.Comments {
+		createdAt, _ := parseFixtureTime(c.CreatedAt)
+		comment := &service.Comment{Id: c.ID, Content: c.Content, AuthorId: c.AuthorID, PostId: c.PostID, CreatedAt: createdAt}
+		if err := comments.SaveComment(ctx, comment); err != nil {
+			return fmt.Errorf("failed to seed comment %s: %w", comment.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// parseUserRole converts a GraphQL UserRole value (e.g. ADMIN) into its proto enum
+func parseUserRole(value string) (service.UserRole, error) {
+	role, ok := service.UserRole_value["USER_ROLE_"+value]
+	if !ok || role == int32(service.UserRole_USER_ROLE_UNSPECIFIED) {
+		return service.UserRole_USER_ROLE_UNSPECIFIED, fmt.Errorf("unknown role %q", value)
+	}
+	return service.UserRole(role), nil
+}
+
+// parseTheme converts a GraphQL Theme value (e.g. DARK) into its proto enum.
+// An empty value means the theme is not set.
+func parseTheme(value string) (service.Theme, error) {
+	if value == "" {
+		return service.Theme_THEME_UNSPECIFIED, nil
+	}
+	theme, ok := service.Theme_value["THEME_"+value]
+	if !ok || theme == int32(service.Theme_THEME_UNSPECIFIED) {
+		return service.Theme_THEME_UNSPECIFIED, fmt.Errorf("unknown theme %q", value)
+	}
+	return service.Theme(theme), nil
+}
+
+// parsePostStatus converts a GraphQL PostStatus value (e.g. DRAFT) into its
+// proto enum. Posts without a status are published.
+func parsePostStatus(value string) (service.PostStatus, error) {
+	if value == "" {
+		return service.PostStatus_POST_STATUS_PUBLISHED, nil
+	}
+	status, ok := service.PostStatus_value["POST_STATUS_"+value]
+	if !ok || status == int32(service.PostStatus_POST_STATUS_UNSPECIFIED) {
+		return service.PostStatus_POST_STATUS_UNSPECIFIED, fmt.Errorf("unknown status %q", value)
+	}
+	return service.PostStatus(status), nil
+}
+
+// parseFixtureTime converts an RFC 3339 time of a fixture into the format of
+// stored timestamps. An empty value stays empty.
+func parseFixtureTime(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", fmt.Errorf("invalid time %q, expected RFC 3339", value)
+	}
+	return formatTimestamp(t), nil
+}
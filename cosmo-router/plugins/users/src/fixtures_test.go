@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/wundergraph/cosmo/plugin/generated"
+)
+
+func TestLoadDefaultFixture(t *testing.T) {
+	fixture, err := loadFixture("")
+	require.NoError(t, err)
+	assert.Len(t, fixture.Users, 4)
+	assert.Len(t, fixture.Posts, 4)
+	assert.Len(t, fixture.Comments, 4)
+
+	ctx := context.Background()
+	store := NewMemoryStore()
+	require.NoError(t, fixture.seed(ctx, store, store, store))
+
+	// Nullable fields that are missing in the fixture stay unset
+	charlie, err := store.GetUser(ctx, "3")
+	require.NoError(t, err)
+	assert.Equal(t, service.UserRole_USER_ROLE_USER, charlie.Role)
+	assert.Nil(t, charlie.Bio)
+	assert.Nil(t, charlie.Profile.DisplayName)
+	assert.Equal(t, "Asia/Tokyo", charlie.Profile.GetTimezone().GetValue())
+	assert.Equal(t, service.Theme_THEME_AUTO, charlie.Profile.Theme)
+	assert.Equal(t, int32(29), charlie.Age.GetValue())
+
+	// Activity is derived from the posts and comments, most recently created first
+	activity, err := store.ListUserActivity(ctx, "1")
+	require.NoError(t, err)
+	require.Len(t, activity, 3)
+	assert.Equal(t, "Excellent examples provided.", activity[0].GetComment().GetContent())
+	assert.Equal(t, "Advanced Federation Patterns", activity[1].GetPost().GetTitle())
+	assert.Equal(t, "Getting Started with GraphQL", activity[2].GetPost().GetTitle())
+	assert.Equal(t, "2024-01-15T09:00:00.000Z", activity[2].GetPost().GetCreatedAt())
+
+	// Comments are listed under the post they were written on, oldest first
+	post, err := store.GetPost(ctx, "1")
+	require.NoError(t, err)
+	require.Len(t, post.Comments, 2)
+	assert.Equal(t, "Great post! Very helpful.", post.Comments[0].Content)
+	assert.Equal(t, "Thanks for sharing this.", post.Comments[1].Content)
+}
+
+func TestLoadYAMLFixture(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "seed.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+users:
+  - id: "10"
+    name: Erin
+    email: erin@example.com
+    role: ADMIN
+    permissions: [read]
+    skillCategories: [[Go]]
+posts:
+  - id: "20"
+    title: Hello YAML
+    authorId: "10"
+    createdAt: 2024-05-01T14:00:00+02:00
+  - id: "21"
+    title: Undated
+    authorId: "10"
+    status: DRAFT
+`), 0o600))
+
+	fixture, err := loadFixture(path)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	store := NewMemoryStore()
+	require.NoError(t, fixture.seed(ctx, store, store, store))
+
+	user, err := store.GetUser(ctx, "10")
+	require.NoError(t, err)
+	assert.Equal(t, "Erin", user.Name)
+	assert.Equal(t, service.UserRole_USER_ROLE_ADMIN, user.Role)
+	assert.Nil(t, user.Tags)
+	assert.Nil(t, user.Profile)
+	require.Len(t, user.RecentActivity, 2)
+
+	// Posts without createdAt are created when they are seeded
+	assert.Equal(t, "Undated", user.RecentActivity[0].GetPost().GetTitle())
+	assert.Equal(t, "Hello YAML", user.RecentActivity[1].GetPost().GetTitle())
+	assert.Equal(t, "2024-05-01T12:00:00.000Z", user.RecentActivity[1].GetPost().GetCreatedAt())
+
+	// Posts without a status are published
+	assert.Equal(t, service.PostStatus_POST_STATUS_DRAFT, user.RecentActivity[0].GetPost().GetStatus())
+	assert.Equal(t, service.PostStatus_POST_STATUS_PUBLISHED, user.RecentActivity[1].GetPost().GetStatus())
+}
+
+func TestFixtureValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		wantErr []string
+	}{
+		{
+			name:    "unknown fields are rejected",
+			fixture: `{"users": [{"id": "1", "role": "USER", "nickname": "al"}]}`,
+			wantErr: []string{`unknown field "nickname"`},
+		},
+		{
+			name: "unknown authors and posts",
+			fixture: `{
+				"users": [{"id": "1", "role": "USER"}],
+				"posts": [{"id": "1", "title": "Post", "authorId": "2"}],
+				"comments": [{"id": "1", "content": "Comment", "authorId": "3", "postId": "2"}]
+			}`,
+			wantErr: []string{
+				`posts[0].authorId: unknown user "2"`,
+				`comments[0].authorId: unknown user "3"`,
+				`comments[0].postId: unknown post "2"`,
+			},
+		},
+		{
+			name: "duplicate and missing ids",
+			fixture: `{
+				"users": [{"id": "1", "role": "USER"}, {"id": "1", "role": "USER"}, {"role": "USER"}]
+			}`,
+			wantErr: []string{
+				`users[1]: duplicate id "1"`,
+				`users[2]: id is required`,
+			},
+		},
+		{
+			name: "invalid creation times",
+			fixture: `{
+				"users": [{"id": "1", "role": "USER"}],
+				"posts": [{"id": "1", "title": "Post", "authorId": "1", "createdAt": "yesterday"}],
+				"comments": [{"id": "1", "content": "Comment", "authorId": "1", "postId": "1", "createdAt": "2024-05-01"}]
+			}`,
+			wantErr: []string{
+				`posts[0].createdAt: invalid time "yesterday"`,
+				`comments[0].createdAt: invalid time "2024-05-01"`,
+			},
+		},
+		{
+			name: "unknown enum values",
+			fixture: `{
+				"users": [{"id": "1", "role": "ROOT", "profile": {"theme": "NEON"}}],
+				"posts": [{"id": "1", "title": "Post", "authorId": "1", "status": "HIDDEN"}]
+			}`,
+			wantErr: []string{
+				`users[0].role: unknown role "ROOT"`,
+				`users[0].profile.theme: unknown theme "NEON"`,
+				`posts[0].status: unknown status "HIDDEN"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseFixture([]byte(tt.fixture), false)
+			require.Error(t, err)
+			for _, want := range tt.wantErr {
+				assert.ErrorContains(t, err, want)
+			}
+		})
+	}
+}
+
+func TestYAMLFixtureUnknownFields(t *testing.T) {
+	_, err := parseFixture([]byte(`
+users:
+  - id: "1"
+    role: USER
+    nickname: al
+`), true)
+	assert.ErrorContains(t, err, "field nickname not found")
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+)
+
+// Kinds of records that IDs are generated for
+const (
+	idKindUser    = "user"
+	idKindPost    = "post"
+	idKindComment = "comment"
+)
+
+// Interface guards to ensure that all generators implement IDGenerator
+var (
+	_ IDGenerator = (*MemoryStore)(nil)
+	_ IDGenerator = (*BoltStore)(nil)
+	_ IDGenerator = ulidGenerator{}
+	_ IDGenerator = uuidV7Generator{}
+)
+
+// IDGenerator hands out IDs for new users, posts and comments.
+// Implementations must never return the same ID twice for a kind, also not to
+// concurrent callers, and must be safe for concurrent use.
+type IDGenerator interface {
+	// NextID returns a new ID for a record of the given kind
+	NextID(ctx context.Context, kind string) (string, error)
+}
+
+// ulidGenerator generates ULIDs, which sort by creation time.
+// IDs are unique across kinds and across plugin processes.
+type ulidGenerator struct{}
+
+// NextID returns a new ULID. ULIDs created within the same millisecond are
+// monotonically increasing.
+func (ulidGenerator) NextID(ctx context.Context, kind string) (string, error) {
+	return ulid.Make().String(), nil
+}
+
+// uuidV7Generator generates version 7 UUIDs, which sort by creation time.
+// IDs are unique across kinds and across plugin processes.
+type uuidV7Generator struct{}
+
+// NextID returns a new UUIDv7
+func (uuidV7Generator) NextID(ctx context.Context, kind string) (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("failed to read random bytes: %w", err)
+	}
+	return id.String(), nil
+}
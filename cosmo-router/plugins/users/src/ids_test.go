@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/wundergraph/cosmo/plugin/generated"
+)
+
+func TestConcurrentCreatePostIDs(t *testing.T) {
+	const posts = 50
+
+	// The default scheme is the sequence, which every backend stores itself;
+	// the generated schemes don't depend on the backend
+	configs := testStoreConfigs()
+	configs["ulid"] = func(t *testing.T) *Config {
+		return &Config{StoreBackend: storeBackendMemory, IDScheme: idSchemeULID}
+	}
+	configs["uuidv7"] = func(t *testing.T) *Config {
+		return &Config{StoreBackend: storeBackendMemory, IDScheme: idSchemeUUIDv7}
+	}
+
+	for name, config := range configs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			cfg := config(t)
+			stores, err := openStore(cfg)
+			require.NoError(t, err)
+			defer stores.close()
+			seedTestStores(t, stores)
+
+			svc := setupTestServiceWithStores(t, stores)
+			defer svc.cleanup()
+
+			ids := make(chan string, posts)
+			var wg sync.WaitGroup
+			for i := 0; i < posts; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					resp, err := svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{
+						Input: &service.PostInput{Title: "Concurrent Post", AuthorId: "1"},
+					})
+					if assert.NoError(t, err) {
+						ids <- resp.CreatePost.Id
+					}
+				}()
+			}
+			wg.Wait()
+			close(ids)
+
+			seen := make(map[string]bool)
+			for id := range ids {
+				assert.False(t, seen[id], "ID %s was handed out twice", id)
+				seen[id] = true
+
+				switch cfg.IDScheme {
+				case idSchemeULID:
+					_, err := ulid.ParseStrict(id)
+					assert.NoError(t, err)
+				case idSchemeUUIDv7:
+					parsed, err := uuid.Parse(id)
+					if assert.NoError(t, err) {
+						assert.Equal(t, uuid.Version(7), parsed.Version())
+					}
+				}
+			}
+			assert.Len(t, seen, posts)
+
+			// None of the created posts may have replaced another one
+			all, err := stores.posts.ListPosts(ctx)
+			require.NoError(t, err)
+			assert.Len(t, all, 4+posts)
+		})
+	}
+}
+
+func TestSequenceIDsAreNotReused(t *testing.T) {
+	for name, config := range testStoreConfigs() {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			stores, err := openStore(config(t))
+			require.NoError(t, err)
+			defer stores.close()
+			seedTestStores(t, stores)
+
+			// The seed takes IDs 1 to 4, so deleting the newest post must not free its ID
+			require.NoError(t, stores.posts.DeletePost(ctx, "4", time.Now()))
+			id, err := stores.ids.NextID(ctx, idKindPost)
+			require.NoError(t, err)
+			assert.Equal(t, "5", id)
+
+			// Sequences are independent per kind
+			id, err = stores.ids.NextID(ctx, idKindUser)
+			require.NoError(t, err)
+			assert.Equal(t, "5", id)
+
+			_, err = stores.ids.NextID(ctx, "tag")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestBoltSequenceSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	cfg := &Config{StoreBackend: storeBackendBolt, StorePath: filepath.Join(t.TempDir(), "users.db")}
+
+	stores, err := openStore(cfg)
+	require.NoError(t, err)
+	seedTestStores(t, stores)
+	id, err := stores.ids.NextID(ctx, idKindComment)
+	require.NoError(t, err)
+	assert.Equal(t, "5", id)
+	require.NoError(t, stores.close())
+
+	// The ID handed out before the restart was never saved, but must not come back
+	stores, err = openStore(cfg)
+	require.NoError(t, err)
+	defer stores.close()
+	id, err = stores.ids.NextID(ctx, idKindComment)
+	require.NoError(t, err)
+	assert.Equal(t, "6", id)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"slices"
+	"sync"
+)
+
+// userLocks serialises the writes of each user within the plugin process.
+// Updates read a user, change it and save it with the version they read, so
+// without the lock two concurrent updates of the same user would make one of
+// them fail with ErrVersionConflict, even when the caller didn't ask for a
+// version check. The zero value is ready for use.
+type userLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock locks the users with the given IDs and returns a function that unlocks
+// them again. IDs may repeat. Users are always locked in the same order, so
+// callers locking several users at once can't deadlock each other.
+func (l *userLocks) lock(ids ...string) (unlock func()) {
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*sync.Mutex)
+	}
+	locks := make([]*sync.Mutex, 0, len(ids))
+	for _, id := range ids {
+		lock, found := l.locks[id]
+		if !found {
+			lock = &sync.Mutex{}
+			l.locks[id] = lock
+		}
+		locks = append(locks, lock)
+	}
+	l.mu.Unlock()
+
+	for _, lock := range locks {
+		lock.Lock()
+	}
+	return func() {
+		for _, lock := range slices.Backward(locks) {
+			lock.Unlock()
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserLocks(t *testing.T) {
+	var locks userLocks
+
+	// Repeated IDs are locked once
+	locks.lock("1", "2", "1")()
+
+	// Overlapping sets of users locked in any order exclude each other
+	var wg sync.WaitGroup
+	counts := map[string]*int{"1": new(int), "2": new(int), "3": new(int)}
+	for _, ids := range [][]string{{"1", "2"}, {"2", "1"}, {"2", "3"}, {"3"}} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				unlock := locks.lock(ids...)
+				for _, id := range ids {
+					*counts[id]++
+				}
+				unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 200, *counts["1"])
+	assert.Equal(t, 300, *counts["2"])
+	assert.Equal(t, 200, *counts["3"])
+}
@@ -4,8 +4,12 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"slices"
 	"time"
 
 	service "github.com/wundergraph/cosmo/plugin/generated"
@@ -13,6 +17,7 @@ import (
 	routerplugin "github.com/wundergraph/cosmo/router-plugin"
 	"github.com/wundergraph/cosmo/router-plugin/httpclient"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -21,8 +26,29 @@ var httpClient *httpclient.Client
 
 // main initializes and starts the router plugin service
 func main() {
+	cfg, err := loadConfig(os.LookupEnv)
+	if err != nil {
+		log.Fatalf("failed to load plugin configuration: %v", err)
+	}
+
+	fixture, err := loadFixture(cfg.SeedFile)
+	if err != nil {
+		log.Fatalf("failed to load seed data: %v", err)
+	}
+
+	// Open the configured store and seed it with the fixture on first use
+	stores, err := openStore(cfg)
+	if err != nil {
+		log.Fatalf("failed to open store: %v", err)
+	}
+	defer stores.close()
+
+	if err := seedIfEmpty(context.Background(), stores, fixture); err != nil {
+		log.Fatalf("failed to seed store: %v", err)
+	}
+
 	pl, err := routerplugin.NewRouterPlugin(func(s *grpc.Server) {
-		s.RegisterService(&service.UsersService_ServiceDesc, &UsersService{})
+		s.RegisterService(&service.UsersService_ServiceDesc, NewUsersService(stores.users, stores.posts, stores.comments, stores.audit, stores.ids, cfg.DeletePolicy))
 	})
 
 	if err != nil {
@@ -44,122 +70,325 @@ var _ service.UsersServiceServer = (*UsersService)(nil)
 // UsersService implements the gRPC service for user management
 type UsersService struct {
 	service.UnimplementedUsersServiceServer
+
+	users        UserStore
+	posts        PostStore
+	comments     CommentStore
+	audit        AuditStore
+	ids          IDGenerator
+	search       *searchIndex
+	deletePolicy DeletePolicy
+	userLocks    userLocks
+}
+
+// NewUsersService creates a UsersService backed by the given stores.
+// Mutations record their changes in audit, ids generates the IDs of created
+// records, and deletePolicy decides what MutationDeleteUser does with the posts
+// and comments of a deleted user.
+func NewUsersService(users UserStore, posts PostStore, comments CommentStore, audit AuditStore, ids IDGenerator, deletePolicy DeletePolicy) *UsersService {
+	return &UsersService{
+		users:        users,
+		posts:        posts,
+		comments:     comments,
+		audit:        audit,
+		ids:          ids,
+		search:       newSearchIndex(),
+		deletePolicy: deletePolicy,
+	}
 }
 
 // LookupUserById implements the batch lookup functionality.
 // It receives an array of user IDs and returns the corresponding user objects.
 // This method is optimized for DataLoader patterns in GraphQL resolvers.
 func (s *UsersService) LookupUserById(ctx context.Context, req *service.LookupUserByIdRequest) (*service.LookupUserByIdResponse, error) {
+	ctx = withRequestViewer(ctx)
 	response := &service.LookupUserByIdResponse{
 		Result: make([]*service.User, 0, len(req.Keys)),
 	}
 
 	// Process each key in the batch request
 	for _, key := range req.Keys {
-		if user, found := mockUsers[key.Id]; found {
+		user, err := s.users.GetUser(ctx, key.Id)
+		switch {
+		case err == nil:
 			response.Result = append(response.Result, user)
-		} else {
+		case errors.Is(err, ErrNotFound):
 			// Return nil or empty user for keys that don't exist
 			response.Result = append(response.Result, &service.User{Id: key.Id})
+		default:
+			return nil, unavailable(fmt.Errorf("failed to look up user %s: %w", key.Id, err))
 		}
 	}
 
 	return response, nil
 }
 
-// QueryUser looks up a single user by ID.
-// Returns the user if found, otherwise returns an empty response.
-func (s *UsersService) QueryUser(ctx context.Context, req *service.QueryUserRequest) (*service.QueryUserResponse, error) {
-	response := &service.QueryUserResponse{}
+// LookupPostById resolves Post entities for other subgraphs, returning one
+// post per key in the order of the keys. Like LookupUserById, keys that match
+// no post, a deleted post, or a draft or archived post of another user than
+// the actor of the request get a placeholder with just the ID.
+func (s *UsersService) LookupPostById(ctx context.Context, req *service.LookupPostByIdRequest) (*service.LookupPostByIdResponse, error) {
+	ctx = withRequestViewer(ctx)
+	response := &service.LookupPostByIdResponse{
+		Result: make([]*service.Post, 0, len(req.Keys)),
+	}
 
-	if user, found := mockUsers[req.Id]; found {
-		response.User = user
+	for _, key := range req.Keys {
+		post, err := s.posts.GetPost(ctx, key.Id)
+		switch {
+		case err == nil:
+			response.Result = append(response.Result, post)
+		case errors.Is(err, ErrNotFound):
+			response.Result = append(response.Result, &service.Post{Id: key.Id})
+		default:
+			return nil, unavailable(fmt.Errorf("failed to look up post %s: %w", key.Id, err))
+		}
 	}
 
 	return response, nil
 }
 
-// QueryUsers returns all users from the mock data store.
-// This method doesn't support pagination or filtering in this implementation.
-func (s *UsersService) QueryUsers(ctx context.Context, req *service.QueryUsersRequest) (*service.QueryUsersResponse, error) {
-	response := &service.QueryUsersResponse{
-		Users: make([]*service.User, 0, len(mockUsers)),
+// LookupCommentById resolves Comment entities for other subgraphs, returning
+// one comment per key in the order of the keys. Keys that match no comment, a
+// deleted one, or one on a post hidden from the actor of the request get a
+// placeholder with just the ID.
+func (s *UsersService) LookupCommentById(ctx context.Context, req *service.LookupCommentByIdRequest) (*service.LookupCommentByIdResponse, error) {
+	ctx = withRequestViewer(ctx)
+	response := &service.LookupCommentByIdResponse{
+		Result: make([]*service.Comment, 0, len(req.Keys)),
 	}
 
-	for _, user := range mockUsers {
-		response.Users = append(response.Users, user)
+	for _, key := range req.Keys {
+		comment, err := s.comments.GetComment(ctx, key.Id)
+		switch {
+		case err == nil:
+			response.Result = append(response.Result, comment)
+		case errors.Is(err, ErrNotFound):
+			response.Result = append(response.Result, &service.Comment{Id: key.Id})
+		default:
+			return nil, unavailable(fmt.Errorf("failed to look up comment %s: %w", key.Id, err))
+		}
 	}
 
 	return response, nil
 }
 
-// MutationUpdateUser updates a user's information.
-// Only updates fields that are provided in the input.
-// Returns the updated user if found, otherwise returns an empty response.
-func (s *UsersService) MutationUpdateUser(ctx context.Context, req *service.MutationUpdateUserRequest) (*service.MutationUpdateUserResponse, error) {
-	response := &service.MutationUpdateUserResponse{}
+// QueryUser looks up a single user by ID.
+// Returns the user if found, otherwise a NotFound error. Deleted users are only
+// found with includeDeleted, which is reserved for admins.
+func (s *UsersService) QueryUser(ctx context.Context, req *service.QueryUserRequest) (*service.QueryUserResponse, error) {
+	ctx = withRequestViewer(ctx)
+	ctx, err := s.withDeletedForAdmin(ctx, req.IncludeDeleted)
+	if err != nil {
+		return nil, err
+	}
 
-	// Check if user exists
-	user, found := mockUsers[req.Input.Id]
-	if !found {
-		return response, nil
+	user, err := s.users.GetUser(ctx, req.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourceUser, req.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get user %s: %w", req.Id, err))
 	}
 
-	// Update user fields if provided in the input
-	if req.Input.Name.GetValue() != "" {
-		user.Name = req.Input.Name.GetValue()
+	return &service.QueryUserResponse{User: user}, nil
+}
+
+// QueryUsers returns the users matching filter, sorted by orderBy (by ID when
+// not given). Deleted users are only listed with includeDeleted, which is
+// reserved for admins.
+// Use QueryUsersConnection to page through large user lists.
+func (s *UsersService) QueryUsers(ctx context.Context, req *service.QueryUsersRequest) (*service.QueryUsersResponse, error) {
+	ctx = withRequestViewer(ctx)
+	ctx, err := s.withDeletedForAdmin(ctx, req.IncludeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	ordering, err := newUserOrdering(req.OrderBy)
+	if err != nil {
+		return nil, invalidArgumentError(err)
 	}
 
-	if req.Input.Email.GetValue() != "" {
-		user.Email = req.Input.Email.GetValue()
+	users, err := s.users.ListUsers(ctx)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to list users: %w", err))
 	}
+	users = filterUsers(users, req.Filter)
+	ordering.sort(users)
 
-	// Update role if provided
-	if req.Input.Role != service.UserRole_USER_ROLE_UNSPECIFIED {
-		user.Role = req.Input.Role
+	response := &service.QueryUsersResponse{
+		Users: users,
 	}
 
-	if len(req.Input.Permissions.GetList().GetItems()) > 0 {
-		user.Permissions = req.Input.Permissions.GetList().GetItems()
+	return response, nil
+}
+
+// QueryUsersConnection returns a page of users, filtered and sorted the same way
+// as QueryUsers. totalCount is the number of users matching the filter.
+// Cursors encode the sort key of a user, so they remain stable when users are
+// added or removed between requests.
+func (s *UsersService) QueryUsersConnection(ctx context.Context, req *service.QueryUsersConnectionRequest) (*service.QueryUsersConnectionResponse, error) {
+	ctx = withRequestViewer(ctx)
+	ctx, err := s.withDeletedForAdmin(ctx, req.IncludeDeleted)
+	if err != nil {
+		return nil, err
+	}
+	args, err := parseConnectionArgs(req.First, req.After, req.Last, req.Before)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	ordering, err := newUserOrdering(req.OrderBy)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	users, err := s.users.ListUsers(ctx)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to list users: %w", err))
+	}
+	users = filterUsers(users, req.Filter)
+	ordering.sort(users)
+
+	page, err := paginate(users, args, ordering.compare, ordering.cursorCodec())
+	if err != nil {
+		return nil, invalidArgumentError(err)
 	}
 
-	if len(req.Input.Tags.GetList().GetItems()) > 0 {
-		user.Tags = &service.ListOfString{List: &service.ListOfString_List{Items: req.Input.Tags.GetList().GetItems()}}
+	connection := &service.UserConnection{
+		Edges:      make([]*service.UserEdge, 0, len(page.Items)),
+		PageInfo:   page.PageInfo,
+		TotalCount: int32(len(users)),
 	}
+	for i, user := range page.Items {
+		connection.Edges = append(connection.Edges, &service.UserEdge{Node: user, Cursor: page.Cursors[i]})
+	}
+
+	return &service.QueryUsersConnectionResponse{UsersConnection: connection}, nil
+}
 
-	// Update skill categories if provided
-	if len(req.Input.SkillCategories.GetList().GetItems()) > 0 {
-		user.SkillCategories = req.Input.SkillCategories
+// QuerySearch searches users, posts and comments by name, email, bio, display
+// name, post title and comment content, best matches first.
+func (s *UsersService) QuerySearch(ctx context.Context, req *service.QuerySearchRequest) (*service.QuerySearchResponse, error) {
+	limit := 10
+	if req.First != nil {
+		if req.First.Value < 0 || req.First.Value > maxPageSize {
+			return nil, invalidArgument("", []*fieldError{{Field: "first", Message: fmt.Sprintf("must be between 0 and %d, got %d", maxPageSize, req.First.Value)}})
+		}
+		limit = int(req.First.Value)
 	}
 
-	// Update bio if provided
-	if req.Input.Bio.GetValue() != "" {
-		user.Bio = req.Input.Bio
+	var types []service.SearchableType
+	if req.Types != nil {
+		// An empty list searches nothing, a missing one searches everything
+		types = append([]service.SearchableType{}, req.Types.GetList().GetItems()...)
 	}
 
-	// Update age if provided
-	if req.Input.Age != nil {
-		user.Age = req.Input.Age
+	// The index is shared by all requests, so it holds the posts of every status
+	if err := s.search.ensureBuilt(ctx, s.users, s.posts, s.comments); err != nil {
+		return nil, unavailable(fmt.Errorf("failed to build search index: %w", err))
 	}
 
-	// Update profile if provided
-	if req.Input.Profile != nil {
-		if user.Profile == nil {
-			user.Profile = &service.Profile{}
+	// Drafts and archived posts of other users are skipped like removed records
+	ctx = withRequestViewer(ctx)
+
+	response := &service.QuerySearchResponse{
+		Search: make([]*service.SearchResult, 0, limit),
+	}
+	// Hidden records must not use up the limit, so walk all matches until
+	// enough visible ones are found
+	for _, hit := range s.search.match(req.Query, types) {
+		if len(response.Search) == limit {
+			break
 		}
-		if req.Input.Profile.DisplayName.GetValue() != "" {
-			user.Profile.DisplayName = req.Input.Profile.DisplayName
+		result, err := s.searchResult(ctx, hit.Doc)
+		if errors.Is(err, ErrNotFound) {
+			// The record was removed after it has been indexed
+			continue
+		}
+		if err != nil {
+			return nil, unavailable(err)
+		}
+		response.Search = append(response.Search, result)
+	}
+
+	return response, nil
+}
+
+// searchResult loads the current version of an indexed record
+func (s *UsersService) searchResult(ctx context.Context, doc searchDoc) (*service.SearchResult, error) {
+	switch doc.Type {
+	case service.SearchableType_SEARCHABLE_TYPE_USER:
+		user, err := s.users.GetUser(ctx, doc.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user %s: %w", doc.ID, err)
 		}
-		if req.Input.Profile.Timezone.GetValue() != "" {
-			user.Profile.Timezone = req.Input.Profile.Timezone
+		return &service.SearchResult{Value: &service.SearchResult_User{User: user}}, nil
+	case service.SearchableType_SEARCHABLE_TYPE_POST:
+		post, err := s.posts.GetPost(ctx, doc.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get post %s: %w", doc.ID, err)
 		}
-		if req.Input.Profile.Theme != service.Theme_THEME_UNSPECIFIED {
-			user.Profile.Theme = req.Input.Profile.Theme
+		return &service.SearchResult{Value: &service.SearchResult_Post{Post: post}}, nil
+	default:
+		comment, err := s.comments.GetComment(ctx, doc.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get comment %s: %w", doc.ID, err)
 		}
+		return &service.SearchResult{Value: &service.SearchResult_Comment{Comment: comment}}, nil
+	}
+}
+
+// MutationUpdateUser updates a user's information.
+// Only updates fields that are provided in the input.
+// Returns the updated user if found, otherwise a NotFound error. Fails with an
+// Aborted error if the input expects another version of the user, or if the
+// user is changed concurrently.
+func (s *UsersService) MutationUpdateUser(ctx context.Context, req *service.MutationUpdateUserRequest) (*service.MutationUpdateUserResponse, error) {
+	if req.Input == nil {
+		return nil, invalidArgument("", []*fieldError{{Field: "input", Message: "is required"}})
+	}
+	if errs := validateUserInput(req.Input); len(errs) > 0 {
+		return nil, invalidArgument("input.", errs)
+	}
+
+	response := &service.MutationUpdateUserResponse{}
+	defer s.userLocks.lock(req.Input.Id)()
+
+	// Check if user exists. The store ignores recentActivity on save, so the
+	// user is read like QueryUser does for the response.
+	user, err := s.users.GetUser(withRequestViewer(ctx), req.Input.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourceUser, req.Input.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get user %s: %w", req.Input.Id, err))
+	}
+	if expected := req.Input.ExpectedVersion; expected != nil && expected.Value != user.Version {
+		return nil, versionConflict(user.Id, expected.Value, user.Version)
 	}
 
-	// Update the user in our mock database
-	mockUsers[req.Input.Id] = user
+	diff, err := applyUserInput(user, req.Input)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	// Update the user in the store unless the input didn't change anything.
+	// The store rejects the save if the user has been changed since it was
+	// read, which the lock only prevents within this process.
+	if len(diff) > 0 {
+		err := s.users.SaveUser(ctx, user)
+		if errors.Is(err, ErrVersionConflict) {
+			return nil, concurrentUpdate(err)
+		}
+		if err != nil {
+			return nil, unavailable(fmt.Errorf("failed to save user %s: %w", user.Id, err))
+		}
+		s.search.indexUser(user)
+
+		if err := s.recordAudit(ctx, newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_UPDATE_USER, user.Id, diff, time.Now())); err != nil {
+			return nil, err
+		}
+	}
 
 	// Return the updated user
 	response.UpdateUser = user
@@ -168,87 +397,145 @@ func (s *UsersService) MutationUpdateUser(ctx context.Context, req *service.Muta
 }
 
 // MutationUpdateUsers updates multiple users' information in a batch.
-// Only updates fields that are provided in each input.
-// Returns the updated users that were found, skipping any that don't exist.
+// Only updates fields that are provided or unset in each input.
+// Returns one result per input that tells whether the input was applied, or
+// why not. All inputs are checked before the changed users are saved in one
+// store transaction. If the batch is atomic and any input fails, nothing is saved.
+// Several inputs for the same user are applied in order, and their expected
+// versions refer to the version before the batch.
 func (s *UsersService) MutationUpdateUsers(ctx context.Context, req *service.MutationUpdateUsersRequest) (*service.MutationUpdateUsersResponse, error) {
-	response := &service.MutationUpdateUsersResponse{
-		UpdateUsers: make([]*service.User, 0, len(req.Input)),
+	results := make([]*service.UpdateUserResult, len(req.Input))
+	users := make(map[string]*service.User, len(req.Input))
+	originals := make(map[string]*service.User, len(req.Input))
+	changed := make(map[string]bool, len(req.Input))
+	var changedUsers []*service.User
+	failed := false
+
+	ids := make([]string, len(req.Input))
+	for i, input := range req.Input {
+		ids[i] = input.Id
 	}
+	defer s.userLocks.lock(ids...)()
 
-	// Process each user update in the batch request
-	for _, input := range req.Input {
-		// Skip if no ID is provided
-		if input.Id == "" {
+	for i, input := range req.Input {
+		if errs := validateUserInput(input); len(errs) > 0 {
+			results[i] = validationFailedResult(input.Id, errs[0])
+			failed = true
 			continue
 		}
 
-		// Check if user exists
-		user, found := mockUsers[input.Id]
+		user, found := users[input.Id]
 		if !found {
+			var err error
+			user, err = s.users.GetUser(withRequestViewer(ctx), input.Id)
+			if errors.Is(err, ErrNotFound) {
+				results[i] = userNotFoundResult(input.Id)
+				failed = true
+				continue
+			}
+			if err != nil {
+				return nil, unavailable(fmt.Errorf("failed to get user %s: %w", input.Id, err))
+			}
+			users[input.Id] = user
+			originals[input.Id] = proto.Clone(user).(*service.User)
+		}
+		if expected := input.ExpectedVersion; expected != nil && expected.Value != user.Version {
+			results[i] = versionConflictResult(input.Id, expected.Value, user.Version)
+			failed = true
 			continue
 		}
 
-		// Update user fields if provided in the input
-		if input.Name.GetValue() != "" {
-			user.Name = input.Name.GetValue()
+		diff, err := applyUserInput(user, input)
+		var fieldErr *fieldError
+		if errors.As(err, &fieldErr) {
+			results[i] = validationFailedResult(input.Id, fieldErr)
+			failed = true
+			continue
 		}
-
-		if input.Email.GetValue() != "" {
-			user.Email = input.Email.GetValue()
+		if err != nil {
+			return nil, internal(fmt.Errorf("failed to apply input for user %s: %w", input.Id, err))
 		}
 
-		// Update role if provided
-		if input.Role != service.UserRole_USER_ROLE_UNSPECIFIED {
-			user.Role = input.Role
+		if len(diff) > 0 && !changed[user.Id] {
+			changed[user.Id] = true
+			changedUsers = append(changedUsers, user)
 		}
+		// Later inputs for the same user must not change this result
+		results[i] = &service.UpdateUserResult{Value: &service.UpdateUserResult_UpdatedUser{
+			UpdatedUser: &service.UpdatedUser{User: proto.Clone(user).(*service.User)},
+		}}
+	}
 
-		if len(input.Permissions.GetList().GetItems()) > 0 {
-			user.Permissions = input.Permissions.GetList().GetItems()
+	if failed && req.Atomic.GetValue() {
+		for i, result := range results {
+			if result.GetUpdatedUser() != nil {
+				results[i] = &service.UpdateUserResult{Value: &service.UpdateUserResult_ValidationFailed{
+					ValidationFailed: &service.ValidationFailed{
+						Id:      req.Input[i].Id,
+						Message: "not applied because other inputs of the atomic batch failed",
+					},
+				}}
+			}
 		}
-
-		if len(input.Tags.GetList().GetItems()) > 0 {
-			user.Tags = &service.ListOfString{List: &service.ListOfString_List{Items: input.Tags.GetList().GetItems()}}
+	} else if len(changedUsers) > 0 {
+		err := s.users.SaveUsers(ctx, changedUsers)
+		if errors.Is(err, ErrVersionConflict) {
+			return nil, concurrentUpdate(err)
 		}
-
-		// Update skill categories if provided
-		if len(input.SkillCategories.GetList().GetItems()) > 0 {
-			user.SkillCategories = input.SkillCategories
+		if err != nil {
+			return nil, unavailable(fmt.Errorf("failed to save users: %w", err))
 		}
-
-		// Update bio if provided
-		if input.Bio.GetValue() != "" {
-			user.Bio = input.Bio
+		now := time.Now()
+		records := make([]*service.AuditRecord, 0, len(changedUsers))
+		for _, user := range changedUsers {
+			s.search.indexUser(user)
+			// Several inputs for the same user are recorded as one change
+			records = append(records, newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_UPDATE_USER, user.Id, diffUsers(originals[user.Id], user), now))
 		}
-
-		// Update age if provided
-		if input.Age != nil {
-			user.Age = input.Age
+		if err := s.recordAudit(ctx, records...); err != nil {
+			return nil, err
 		}
 
-		// Update profile if provided
-		if input.Profile != nil {
-			if user.Profile == nil {
-				user.Profile = &service.Profile{}
-			}
-			if input.Profile.DisplayName.GetValue() != "" {
-				user.Profile.DisplayName = input.Profile.DisplayName
-			}
-			if input.Profile.Timezone.GetValue() != "" {
-				user.Profile.Timezone = input.Profile.Timezone
-			}
-			if input.Profile.Theme != service.Theme_THEME_UNSPECIFIED {
-				user.Profile.Theme = input.Profile.Theme
+		// The results were cloned before the save, so they lack the new versions
+		for _, result := range results {
+			if updated := result.GetUpdatedUser().GetUser(); updated != nil && changed[updated.Id] {
+				saved := users[updated.Id]
+				updated.Version, updated.UpdatedAt = saved.Version, saved.UpdatedAt
 			}
 		}
+	}
 
-		// Update the user in our mock database
-		mockUsers[input.Id] = user
+	return &service.MutationUpdateUsersResponse{
+		UpdateUsers: &service.UpdateUsersPayload{Results: results},
+	}, nil
+}
 
-		// Add the updated user to the response
-		response.UpdateUsers = append(response.UpdateUsers, user)
-	}
+// userNotFoundResult returns the batch update result for an unknown user ID
+func userNotFoundResult(id string) *service.UpdateUserResult {
+	return &service.UpdateUserResult{Value: &service.UpdateUserResult_UserNotFound{
+		UserNotFound: &service.UserNotFound{Id: id, Message: fmt.Sprintf("user %q not found", id)},
+	}}
+}
 
-	return response, nil
+// validationFailedResult returns the batch update result for an invalid input,
+// reporting its first invalid field
+func validationFailedResult(id string, err *fieldError) *service.UpdateUserResult {
+	return &service.UpdateUserResult{Value: &service.UpdateUserResult_ValidationFailed{
+		ValidationFailed: &service.ValidationFailed{Id: id, Field: wrapperspb.String(err.Field), Message: err.Message},
+	}}
+}
+
+// versionConflictResult returns the batch update result for an input that
+// expected another version of the user
+func versionConflictResult(id string, expected, current int32) *service.UpdateUserResult {
+	return &service.UpdateUserResult{Value: &service.UpdateUserResult_VersionConflict{
+		VersionConflict: &service.VersionConflict{
+			Id:              id,
+			ExpectedVersion: expected,
+			CurrentVersion:  current,
+			Message:         versionConflictMessage(id, expected, current),
+		},
+	}}
 }
 
 // QueryExternalUsers fetches users from the JSONPlaceholder API.
@@ -259,13 +546,16 @@ func (s *UsersService) QueryExternalUsers(ctx context.Context, req *service.Quer
 	// Use the httpClient to make the request
 	resp, err := httpClient.Get(ctx, "/users")
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch external users: %w", err)
+		return nil, unavailable(fmt.Errorf("failed to fetch external users: %w", err))
+	}
+	if !resp.IsSuccess() {
+		return nil, unavailable(fmt.Errorf("failed to fetch external users: unexpected status %d", resp.StatusCode))
 	}
 
 	// Unmarshal the JSON response into our data structure
 	externalUsers, err := httpclient.UnmarshalTo[[]ExternalUser](resp)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal external users: %w", err)
+		return nil, unavailable(fmt.Errorf("failed to unmarshal external users: %w", err))
 	}
 
 	// Convert to service.ExternalUser objects
@@ -311,13 +601,19 @@ func (s *UsersService) QueryExternalUser(ctx context.Context, req *service.Query
 	// Use the httpClient to make the request for a specific user
 	resp, err := httpClient.Get(ctx, fmt.Sprintf("/users/%s", req.Id))
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch external user: %w", err)
+		return nil, unavailable(fmt.Errorf("failed to fetch external user: %w", err))
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, notFound(resourceExternalUser, req.Id)
+	}
+	if !resp.IsSuccess() {
+		return nil, unavailable(fmt.Errorf("failed to fetch external user: unexpected status %d", resp.StatusCode))
 	}
 
 	// Unmarshal the JSON response into our data structure
 	user, err := httpclient.UnmarshalTo[ExternalUser](resp)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal external user: %w", err)
+		return nil, unavailable(fmt.Errorf("failed to unmarshal external user: %w", err))
 	}
 
 	// Convert to service.ExternalUser
@@ -351,17 +647,34 @@ func (s *UsersService) QueryExternalUser(ctx context.Context, req *service.Query
 	return response, nil
 }
 
-// QueryUserActivity returns recent activity items for a user
+// QueryUserActivity returns the activity items of a user created within since
+// and until, most recently created first, or a NotFound error if the user
+// doesn't exist. Deleted users and deleted posts and comments are only
+// included with includeDeleted.
 func (s *UsersService) QueryUserActivity(ctx context.Context, req *service.QueryUserActivityRequest) (*service.QueryUserActivityResponse, error) {
 	response := &service.QueryUserActivityResponse{}
+	ctx = withRequestViewer(ctx)
+	if req.Limit != nil && req.Limit.Value < 0 {
+		return nil, invalidArgument("", []*fieldError{{Field: "limit", Message: fmt.Sprintf("must not be negative, got %d", req.Limit.Value)}})
+	}
+	window, err := parseTimeRange(req.Since, req.Until)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	ctx, err = s.withDeletedForAdmin(ctx, req.IncludeDeleted)
+	if err != nil {
+		return nil, err
+	}
 
-	// Get activities for the user from our mock data
-	activities, found := userActivityMap[req.UserId]
-	if !found {
-		// Return empty list if user not found
-		response.UserActivity = []*service.ActivityItem{}
-		return response, nil
+	// Get activities for the user from the store
+	activities, err := s.users.ListUserActivity(ctx, req.UserId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourceUser, req.UserId)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get activity for user %s: %w", req.UserId, err))
 	}
+	activities = window.filter(activities)
 
 	// Apply limit if specified
 	limit := int(req.Limit.GetValue())
@@ -374,44 +687,648 @@ func (s *UsersService) QueryUserActivity(ctx context.Context, req *service.Query
 	return response, nil
 }
 
+// QueryUserHistory returns a page of the audit records of a user, most recent
+// first. The history of deleted users remains available, and users without any
+// recorded change have an empty history.
+func (s *UsersService) QueryUserHistory(ctx context.Context, req *service.QueryUserHistoryRequest) (*service.QueryUserHistoryResponse, error) {
+	args, err := parseConnectionArgs(req.First, req.After, nil, nil)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	records, err := s.audit.ListAuditRecords(ctx, req.UserId)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get history of user %s: %w", req.UserId, err))
+	}
+
+	page, err := paginate(records, args, compareAuditRecords, auditCursorCodec)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	connection := &service.AuditRecordConnection{
+		Edges:      make([]*service.AuditRecordEdge, 0, len(page.Items)),
+		PageInfo:   page.PageInfo,
+		TotalCount: int32(len(records)),
+	}
+	for i, record := range page.Items {
+		connection.Edges = append(connection.Edges, &service.AuditRecordEdge{Node: record, Cursor: page.Cursors[i]})
+	}
+
+	return &service.QueryUserHistoryResponse{UserHistory: connection}, nil
+}
+
+// QueryUserActivityConnection returns a page of the activity items of a user,
+// most recently created first, or a NotFound error if the user doesn't exist.
+// Cursors encode the creation time and ID of an item, so they remain stable
+// when posts and comments are added between requests.
+func (s *UsersService) QueryUserActivityConnection(ctx context.Context, req *service.QueryUserActivityConnectionRequest) (*service.QueryUserActivityConnectionResponse, error) {
+	ctx = withRequestViewer(ctx)
+	args, err := parseConnectionArgs(req.First, req.After, nil, nil)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	window, err := parseTimeRange(req.Since, req.Until)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	ctx, err = s.withDeletedForAdmin(ctx, req.IncludeDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := s.users.ListUserActivity(ctx, req.UserId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourceUser, req.UserId)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get activity for user %s: %w", req.UserId, err))
+	}
+	activities = filterActivityTypes(window.filter(activities), req.Types)
+
+	page, err := paginate(activities, args, compareActivity, activityCursorCodec)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	connection := &service.ActivityConnection{
+		Edges:      make([]*service.ActivityEdge, 0, len(page.Items)),
+		PageInfo:   page.PageInfo,
+		TotalCount: int32(len(activities)),
+	}
+	for i, item := range page.Items {
+		connection.Edges = append(connection.Edges, &service.ActivityEdge{Node: item, Cursor: page.Cursors[i]})
+	}
+
+	return &service.QueryUserActivityConnectionResponse{UserActivityConnection: connection}, nil
+}
+
+// QueryPost looks up a single post by ID.
+// Returns the post if found, otherwise a NotFound error. Drafts and archived
+// posts of other users than the actor of the request count as unknown.
+func (s *UsersService) QueryPost(ctx context.Context, req *service.QueryPostRequest) (*service.QueryPostResponse, error) {
+	ctx = withRequestViewer(ctx)
+
+	post, err := s.posts.GetPost(ctx, req.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourcePost, req.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get post %s: %w", req.Id, err))
+	}
+
+	return &service.QueryPostResponse{Post: post}, nil
+}
+
+// QueryPosts returns a page of the posts matching filter, most recently created
+// first. Drafts and archived posts are only listed for their author, so
+// totalCount depends on the actor of the request. Cursors encode the creation
+// time and ID of a post, so they remain stable when posts are added between requests.
+func (s *UsersService) QueryPosts(ctx context.Context, req *service.QueryPostsRequest) (*service.QueryPostsResponse, error) {
+	ctx = withRequestViewer(ctx)
+	args, err := parseConnectionArgs(req.First, req.After, nil, nil)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	posts, err := s.posts.ListPosts(ctx)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to list posts: %w", err))
+	}
+	posts = filterPosts(posts, req.Filter)
+	slices.SortFunc(posts, comparePosts)
+
+	page, err := paginate(posts, args, comparePosts, postCursorCodec)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	connection := &service.PostConnection{
+		Edges:      make([]*service.PostEdge, 0, len(page.Items)),
+		PageInfo:   page.PageInfo,
+		TotalCount: int32(len(posts)),
+	}
+	for i, post := range page.Items {
+		connection.Edges = append(connection.Edges, &service.PostEdge{Node: post, Cursor: page.Cursors[i]})
+	}
+
+	return &service.QueryPostsResponse{Posts: connection}, nil
+}
+
 // MutationCreatePost creates a new post and associates it with the author
 func (s *UsersService) MutationCreatePost(ctx context.Context, req *service.MutationCreatePostRequest) (*service.MutationCreatePostResponse, error) {
+	if req.Input == nil {
+		return nil, invalidArgument("", []*fieldError{{Field: "input", Message: "is required"}})
+	}
+	if errs := validatePostInput(req.Input); len(errs) > 0 {
+		return nil, invalidArgument("input.", errs)
+	}
+
 	response := &service.MutationCreatePostResponse{}
 
 	// Check if the author exists
-	author, found := mockUsers[req.Input.AuthorId]
-	if !found {
-		return nil, fmt.Errorf("author with ID %s not found", req.Input.AuthorId)
+	_, err := s.users.GetUser(ctx, req.Input.AuthorId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourceUser, req.Input.AuthorId)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get author %s: %w", req.Input.AuthorId, err))
 	}
 
-	// Generate a simple ID (in production, this would be from a database)
-	newID := fmt.Sprintf("%d", len(mockPosts)+1)
+	newID, err := s.ids.NextID(ctx, idKindPost)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to generate post ID: %w", err))
+	}
 
-	// Create the new post
+	// Create the new post, published unless the input asks for another status
 	newPost := &service.Post{
 		Id:       newID,
 		Title:    req.Input.Title,
+		Status:   req.Input.Status,
 		AuthorId: req.Input.AuthorId,
 	}
+	if newPost.Status == service.PostStatus_POST_STATUS_UNSPECIFIED {
+		newPost.Status = service.PostStatus_POST_STATUS_PUBLISHED
+	}
 
-	// Add to our mock data
-	mockPosts[newID] = newPost
+	// Add to the post store, which also makes it the author's most recent activity
+	if err := s.posts.SavePost(ctx, newPost); err != nil {
+		return nil, unavailable(fmt.Errorf("failed to save post %s: %w", newID, err))
+	}
+	s.search.indexPost(newPost)
 
-	// Create an activity item for the new post
-	newActivity := &service.ActivityItem{
-		Value: &service.ActivityItem_Post{Post: newPost},
+	if err := s.recordAudit(ctx, newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_CREATE_POST, newPost.AuthorId, createdPostDiff(newPost), time.Now())); err != nil {
+		return nil, err
 	}
 
-	// Add to the author's recent activity (prepend to show most recent first)
-	author.RecentActivity = append([]*service.ActivityItem{newActivity}, author.RecentActivity...)
+	// Return the created post
+	response.CreatePost = newPost
+	return response, nil
+}
 
-	// Update the userActivityMap as well
-	userActivityMap[req.Input.AuthorId] = author.RecentActivity
+// MutationCreateUser creates a new user with an ID from the ID generator.
+// Optional list fields default to empty lists, like they do for seeded users.
+func (s *UsersService) MutationCreateUser(ctx context.Context, req *service.MutationCreateUserRequest) (*service.MutationCreateUserResponse, error) {
+	if req.Input == nil {
+		return nil, invalidArgument("", []*fieldError{{Field: "input", Message: "is required"}})
+	}
+	if errs := validateCreateUserInput(req.Input); len(errs) > 0 {
+		return nil, invalidArgument("input.", errs)
+	}
+	input := req.Input
 
-	// Update the user in our mock database
-	mockUsers[req.Input.AuthorId] = author
+	id, err := s.ids.NextID(ctx, idKindUser)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to generate user ID: %w", err))
+	}
 
-	// Return the created post
-	response.CreatePost = newPost
+	user := &service.User{
+		Id:              id,
+		Name:            input.Name,
+		Email:           input.Email,
+		Role:            input.Role,
+		Permissions:     input.Permissions.GetList().GetItems(),
+		Tags:            input.Tags,
+		SkillCategories: input.SkillCategories,
+		Bio:             input.Bio,
+		Age:             input.Age,
+	}
+	if user.Permissions == nil {
+		user.Permissions = []string{}
+	}
+	if user.SkillCategories == nil {
+		user.SkillCategories = &service.ListOfListOfString{List: &service.ListOfListOfString_List{}}
+	}
+	if input.Profile != nil {
+		user.Profile = &service.Profile{
+			DisplayName: input.Profile.DisplayName,
+			Timezone:    input.Profile.Timezone,
+			Theme:       input.Profile.Theme,
+		}
+	}
+
+	if err := s.users.SaveUser(ctx, user); err != nil {
+		return nil, unavailable(fmt.Errorf("failed to save user %s: %w", user.Id, err))
+	}
+	s.search.indexUser(user)
+
+	if err := s.recordAudit(ctx, newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_CREATE_USER, user.Id, createdUserDiff(user), time.Now())); err != nil {
+		return nil, err
+	}
+
+	return &service.MutationCreateUserResponse{CreateUser: user}, nil
+}
+
+// MutationDeleteUser marks a user as deleted. Depending on the delete policy,
+// the posts and comments of the user are deleted with it, reassigned to another
+// user, or prevent the deletion. Returns the user as it was before the
+// deletion, with its deletedAt set, or a NotFound error if the user doesn't
+// exist or is already deleted.
+func (s *UsersService) MutationDeleteUser(ctx context.Context, req *service.MutationDeleteUserRequest) (*service.MutationDeleteUserResponse, error) {
+	response := &service.MutationDeleteUserResponse{}
+	defer s.userLocks.lock(req.Id)()
+
+	user, err := s.users.GetUser(ctx, req.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourceUser, req.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get user %s: %w", req.Id, err))
+	}
+	// The policy covers all activity of the user, but the response only shows
+	// the activity the caller can see, like QueryUser
+	shown, err := s.users.GetUser(withRequestViewer(ctx), req.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourceUser, req.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get user %s: %w", req.Id, err))
+	}
+
+	// The posts and comments deleted with the user share its deletedAt, which
+	// tells MutationRestoreUser what to restore
+	now := time.Now()
+	switch s.deletePolicy.Mode {
+	case deleteModeCascade:
+		if err := s.deleteActivity(ctx, user.RecentActivity, now); err != nil {
+			return nil, err
+		}
+	case deleteModeReassign:
+		if err := s.reassignActivity(ctx, user.Id, s.deletePolicy.ReassignTo, user.RecentActivity); err != nil {
+			return nil, err
+		}
+	default:
+		if len(user.RecentActivity) > 0 {
+			return nil, failedPrecondition("ACTIVITY", "user/"+user.Id,
+				fmt.Sprintf("user %s still has %d posts and comments, delete them first", user.Id, len(user.RecentActivity)))
+		}
+	}
+
+	err = s.users.DeleteUser(ctx, user.Id, now)
+	if errors.Is(err, ErrNotFound) {
+		// Deleted by a concurrent request
+		return nil, notFound(resourceUser, user.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to delete user %s: %w", user.Id, err))
+	}
+	s.search.unindex(searchDoc{Type: service.SearchableType_SEARCHABLE_TYPE_USER, ID: user.Id})
+
+	deleted, err := s.users.GetUser(withDeleted(ctx), user.Id)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get deleted user %s: %w", user.Id, err))
+	}
+	if err := s.recordAudit(ctx, newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_DELETE_USER, user.Id, diffUsers(user, deleted), now)); err != nil {
+		return nil, err
+	}
+
+	shown.DeletedAt, shown.Version, shown.UpdatedAt = deleted.DeletedAt, deleted.Version, deleted.UpdatedAt
+	response.DeleteUser = shown
 	return response, nil
 }
+
+// MutationRestoreUser restores a deleted user, together with the posts and
+// comments that were deleted with it. Returns the restored user, or a NotFound
+// error if the user doesn't exist. Users that aren't deleted are returned as they are.
+func (s *UsersService) MutationRestoreUser(ctx context.Context, req *service.MutationRestoreUserRequest) (*service.MutationRestoreUserResponse, error) {
+	defer s.userLocks.lock(req.Id)()
+	user, err := s.users.GetUser(withDeleted(ctx), req.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourceUser, req.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get user %s: %w", req.Id, err))
+	}
+	if user.DeletedAt == nil {
+		// Nothing to restore, return the user like QueryUser does
+		shown, err := s.users.GetUser(withRequestViewer(ctx), user.Id)
+		if err != nil {
+			return nil, unavailable(fmt.Errorf("failed to get user %s: %w", user.Id, err))
+		}
+		return &service.MutationRestoreUserResponse{RestoreUser: shown}, nil
+	}
+
+	// Restore the activity first, so a failed restore can be retried while the
+	// user is still deleted
+	if err := s.restoreActivity(ctx, user.RecentActivity, user.DeletedAt.Value); err != nil {
+		return nil, err
+	}
+	if err := s.users.RestoreUser(ctx, user.Id); err != nil {
+		return nil, unavailable(fmt.Errorf("failed to restore user %s: %w", user.Id, err))
+	}
+
+	restored, err := s.users.GetUser(withRequestViewer(ctx), user.Id)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get restored user %s: %w", user.Id, err))
+	}
+	s.search.indexUser(restored)
+
+	if err := s.recordAudit(ctx, newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_RESTORE_USER, user.Id, diffUsers(user, restored), time.Now())); err != nil {
+		return nil, err
+	}
+
+	return &service.MutationRestoreUserResponse{RestoreUser: restored}, nil
+}
+
+// MutationCreateComment creates a comment on a post. The comment is listed
+// under the post and becomes the most recent activity of its author.
+func (s *UsersService) MutationCreateComment(ctx context.Context, req *service.MutationCreateCommentRequest) (*service.MutationCreateCommentResponse, error) {
+	if req.Input == nil {
+		return nil, invalidArgument("", []*fieldError{{Field: "input", Message: "is required"}})
+	}
+	if errs := validateCommentInput(req.Input); len(errs) > 0 {
+		return nil, invalidArgument("input.", errs)
+	}
+	input := req.Input
+
+	// Drafts and archived posts of other users can't be commented on
+	_, err := s.posts.GetPost(withRequestViewer(ctx), input.PostId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourcePost, input.PostId)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get post %s: %w", input.PostId, err))
+	}
+	_, err = s.users.GetUser(ctx, input.AuthorId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourceUser, input.AuthorId)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get author %s: %w", input.AuthorId, err))
+	}
+
+	id, err := s.ids.NextID(ctx, idKindComment)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to generate comment ID: %w", err))
+	}
+
+	comment := &service.Comment{
+		Id:       id,
+		Content:  input.Content,
+		AuthorId: input.AuthorId,
+		PostId:   input.PostId,
+	}
+	if err := s.comments.SaveComment(ctx, comment); err != nil {
+		return nil, unavailable(fmt.Errorf("failed to save comment %s: %w", id, err))
+	}
+	s.search.indexComment(comment)
+
+	if err := s.recordAudit(ctx, newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_CREATE_COMMENT, comment.AuthorId, createdCommentDiff(comment), time.Now())); err != nil {
+		return nil, err
+	}
+
+	return &service.MutationCreateCommentResponse{CreateComment: comment}, nil
+}
+
+// MutationUpdateComment changes the content of a comment. Returns the comment,
+// or a NotFound error if it doesn't exist or is deleted.
+func (s *UsersService) MutationUpdateComment(ctx context.Context, req *service.MutationUpdateCommentRequest) (*service.MutationUpdateCommentResponse, error) {
+	if req.Input == nil {
+		return nil, invalidArgument("", []*fieldError{{Field: "input", Message: "is required"}})
+	}
+	if errs := validateUpdateCommentInput(req.Input); len(errs) > 0 {
+		return nil, invalidArgument("input.", errs)
+	}
+
+	// Comments on drafts and archived posts of other users can't be updated
+	comment, err := s.comments.GetComment(withRequestViewer(ctx), req.Input.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourceComment, req.Input.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get comment %s: %w", req.Input.Id, err))
+	}
+	if comment.Content == req.Input.Content {
+		return &service.MutationUpdateCommentResponse{UpdateComment: comment}, nil
+	}
+
+	before := proto.Clone(comment).(*service.Comment)
+	comment.Content = req.Input.Content
+	if err := s.comments.SaveComment(ctx, comment); err != nil {
+		return nil, unavailable(fmt.Errorf("failed to save comment %s: %w", comment.Id, err))
+	}
+	s.search.indexComment(comment)
+
+	if err := s.recordAudit(ctx, newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_UPDATE_COMMENT, comment.AuthorId, commentDiff(before, comment), time.Now())); err != nil {
+		return nil, err
+	}
+
+	return &service.MutationUpdateCommentResponse{UpdateComment: comment}, nil
+}
+
+// MutationDeleteComment marks a comment as deleted, which removes it from its
+// post and the activity of its author. Returns the deleted comment, or a
+// NotFound error if it doesn't exist or is already deleted.
+func (s *UsersService) MutationDeleteComment(ctx context.Context, req *service.MutationDeleteCommentRequest) (*service.MutationDeleteCommentResponse, error) {
+	// Comments on drafts and archived posts of other users can't be deleted
+	comment, err := s.comments.GetComment(withRequestViewer(ctx), req.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourceComment, req.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get comment %s: %w", req.Id, err))
+	}
+
+	now := time.Now()
+	err = s.comments.DeleteComment(ctx, comment.Id, now)
+	if errors.Is(err, ErrNotFound) {
+		// Deleted by a concurrent request
+		return nil, notFound(resourceComment, comment.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to delete comment %s: %w", comment.Id, err))
+	}
+	s.search.unindex(searchDoc{Type: service.SearchableType_SEARCHABLE_TYPE_COMMENT, ID: comment.Id})
+
+	deleted, err := s.comments.GetComment(withDeleted(ctx), comment.Id)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get deleted comment %s: %w", comment.Id, err))
+	}
+	if err := s.recordAudit(ctx, newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_DELETE_COMMENT, deleted.AuthorId, commentDiff(comment, deleted), now)); err != nil {
+		return nil, err
+	}
+
+	return &service.MutationDeleteCommentResponse{DeleteComment: deleted}, nil
+}
+
+// MutationUpdatePost changes the title or status of a post. Fields that are
+// not given keep their value. Returns the post, or a NotFound error if it
+// doesn't exist or is deleted.
+func (s *UsersService) MutationUpdatePost(ctx context.Context, req *service.MutationUpdatePostRequest) (*service.MutationUpdatePostResponse, error) {
+	if req.Input == nil {
+		return nil, invalidArgument("", []*fieldError{{Field: "input", Message: "is required"}})
+	}
+	if errs := validateUpdatePostInput(req.Input); len(errs) > 0 {
+		return nil, invalidArgument("input.", errs)
+	}
+	input := req.Input
+
+	// Drafts and archived posts of other users can't be updated
+	post, err := s.posts.GetPost(withRequestViewer(ctx), input.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourcePost, input.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get post %s: %w", input.Id, err))
+	}
+	if err := checkAuthor(ctx, post); err != nil {
+		return nil, err
+	}
+
+	before := proto.Clone(post).(*service.Post)
+	if input.Title != nil {
+		post.Title = input.Title.Value
+	}
+	if input.Status != service.PostStatus_POST_STATUS_UNSPECIFIED {
+		post.Status = input.Status
+	}
+	if post.Title == before.Title && post.Status == before.Status {
+		// The input didn't change anything
+		return &service.MutationUpdatePostResponse{UpdatePost: post}, nil
+	}
+
+	if err := s.posts.SavePost(ctx, post); err != nil {
+		return nil, unavailable(fmt.Errorf("failed to save post %s: %w", post.Id, err))
+	}
+	s.search.indexPost(post)
+
+	if err := s.recordAudit(ctx, newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_UPDATE_POST, post.AuthorId, postDiff(before, post), time.Now())); err != nil {
+		return nil, err
+	}
+
+	return &service.MutationUpdatePostResponse{UpdatePost: post}, nil
+}
+
+// MutationDeletePost marks a post as deleted, which removes it from the
+// activity of its author. Comments on the post are left untouched. Returns the
+// deleted post, or a NotFound error if it doesn't exist or is already deleted.
+func (s *UsersService) MutationDeletePost(ctx context.Context, req *service.MutationDeletePostRequest) (*service.MutationDeletePostResponse, error) {
+	// Drafts and archived posts of other users can't be deleted
+	post, err := s.posts.GetPost(withRequestViewer(ctx), req.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound(resourcePost, req.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get post %s: %w", req.Id, err))
+	}
+	if err := checkAuthor(ctx, post); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = s.posts.DeletePost(ctx, post.Id, now)
+	if errors.Is(err, ErrNotFound) {
+		// Deleted by a concurrent request
+		return nil, notFound(resourcePost, post.Id)
+	}
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to delete post %s: %w", post.Id, err))
+	}
+	s.search.unindex(searchDoc{Type: service.SearchableType_SEARCHABLE_TYPE_POST, ID: post.Id})
+
+	deleted, err := s.posts.GetPost(withDeleted(ctx), post.Id)
+	if err != nil {
+		return nil, unavailable(fmt.Errorf("failed to get deleted post %s: %w", post.Id, err))
+	}
+	if err := s.recordAudit(ctx, newAuditRecord(ctx, service.AuditAction_AUDIT_ACTION_DELETE_POST, deleted.AuthorId, postDiff(post, deleted), now)); err != nil {
+		return nil, err
+	}
+
+	return &service.MutationDeletePostResponse{DeletePost: deleted}, nil
+}
+
+// deleteActivity marks the given posts and comments as deleted at the given time
+func (s *UsersService) deleteActivity(ctx context.Context, activity []*service.ActivityItem, at time.Time) error {
+	for _, item := range activity {
+		switch value := item.Value.(type) {
+		case *service.ActivityItem_Post:
+			if err := s.posts.DeletePost(ctx, value.Post.Id, at); err != nil && !errors.Is(err, ErrNotFound) {
+				return unavailable(fmt.Errorf("failed to delete post %s: %w", value.Post.Id, err))
+			}
+			s.search.unindex(searchDoc{Type: service.SearchableType_SEARCHABLE_TYPE_POST, ID: value.Post.Id})
+		case *service.ActivityItem_Comment:
+			if err := s.comments.DeleteComment(ctx, value.Comment.Id, at); err != nil && !errors.Is(err, ErrNotFound) {
+				return unavailable(fmt.Errorf("failed to delete comment %s: %w", value.Comment.Id, err))
+			}
+			s.search.unindex(searchDoc{Type: service.SearchableType_SEARCHABLE_TYPE_COMMENT, ID: value.Comment.Id})
+		}
+	}
+	return nil
+}
+
+// restoreActivity restores the posts and comments of the given activity that
+// were deleted at deletedAt
+func (s *UsersService) restoreActivity(ctx context.Context, activity []*service.ActivityItem, deletedAt string) error {
+	for _, item := range activity {
+		switch value := item.Value.(type) {
+		case *service.ActivityItem_Post:
+			if value.Post.GetDeletedAt().GetValue() != deletedAt {
+				continue
+			}
+			if err := s.posts.RestorePost(ctx, value.Post.Id); err != nil {
+				return unavailable(fmt.Errorf("failed to restore post %s: %w", value.Post.Id, err))
+			}
+			value.Post.DeletedAt = nil
+			s.search.indexPost(value.Post)
+		case *service.ActivityItem_Comment:
+			if value.Comment.GetDeletedAt().GetValue() != deletedAt {
+				continue
+			}
+			if err := s.comments.RestoreComment(ctx, value.Comment.Id); err != nil {
+				return unavailable(fmt.Errorf("failed to restore comment %s: %w", value.Comment.Id, err))
+			}
+			value.Comment.DeletedAt = nil
+			s.search.indexComment(value.Comment)
+		}
+	}
+	return nil
+}
+
+// reassignActivity hands the given posts and comments of userID over to newAuthorID.
+// They keep their creation times, so they are merged into the activity of the
+// new author by when they were created.
+func (s *UsersService) reassignActivity(ctx context.Context, userID, newAuthorID string, activity []*service.ActivityItem) error {
+	if newAuthorID == userID {
+		return failedPrecondition("REASSIGN_TARGET", "user/"+userID,
+			fmt.Sprintf("user %s cannot be deleted, it inherits the posts and comments of deleted users", userID))
+	}
+	_, err := s.users.GetUser(ctx, newAuthorID)
+	if errors.Is(err, ErrNotFound) {
+		return failedPrecondition("REASSIGN_TARGET", "user/"+newAuthorID,
+			fmt.Sprintf("user %s to reassign posts and comments to doesn't exist", newAuthorID))
+	}
+	if err != nil {
+		return unavailable(fmt.Errorf("failed to get user %s to reassign posts and comments to: %w", newAuthorID, err))
+	}
+
+	for i := len(activity) - 1; i >= 0; i-- {
+		switch value := activity[i].Value.(type) {
+		case *service.ActivityItem_Post:
+			post := proto.Clone(value.Post).(*service.Post)
+			post.AuthorId = newAuthorID
+			if err := s.posts.SavePost(ctx, post); err != nil {
+				return unavailable(fmt.Errorf("failed to reassign post %s: %w", post.Id, err))
+			}
+		case *service.ActivityItem_Comment:
+			comment := proto.Clone(value.Comment).(*service.Comment)
+			comment.AuthorId = newAuthorID
+			if err := s.comments.SaveComment(ctx, comment); err != nil {
+				return unavailable(fmt.Errorf("failed to reassign comment %s: %w", comment.Id, err))
+			}
+		}
+	}
+	return nil
+}
+
+// recordAudit appends audit records of the changes made by a mutation. The
+// changes have already been saved when this fails, so the error is reported
+// as Unavailable to let the caller know that the audit log is incomplete.
+func (s *UsersService) recordAudit(ctx context.Context, records ...*service.AuditRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if err := s.audit.AppendAuditRecords(ctx, records); err != nil {
+		return unavailable(fmt.Errorf("failed to record audit log: %w", err))
+	}
+	return nil
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
 	"time"
 
@@ -12,9 +14,14 @@ import (
 	"github.com/stretchr/testify/require"
 	service "github.com/wundergraph/cosmo/plugin/generated"
 	"github.com/wundergraph/cosmo/router-plugin/httpclient"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -55,11 +62,46 @@ func verifyActivityContent(t *testing.T, expectedActivities []*service.ActivityI
 type testService struct {
 	grpcConn    *grpc.ClientConn
 	usersClient service.UsersServiceClient
+	stores      *storeSet
 	cleanup     func()
 }
 
-// setupTestService creates a local gRPC server for testing
+// activity returns the activity items currently stored for a user
+func (ts *testService) activity(t *testing.T, userID string) []*service.ActivityItem {
+	t.Helper()
+	items, err := ts.stores.users.ListUserActivity(context.Background(), userID)
+	if err != nil {
+		require.ErrorIs(t, err, ErrNotFound)
+	}
+	return items
+}
+
+// setupTestService creates a local gRPC server backed by an isolated in-memory store
 func setupTestService(t *testing.T) *testService {
+	// Create an isolated store seeded with the mock data
+	stores, err := openStore(&Config{StoreBackend: storeBackendMemory})
+	require.NoError(t, err)
+	seedTestStores(t, stores)
+
+	return setupTestServiceWithStores(t, stores)
+}
+
+// seedTestStores seeds the stores with the embedded demo fixture
+func seedTestStores(t *testing.T, stores *storeSet) {
+	t.Helper()
+	fixture, err := loadFixture("")
+	require.NoError(t, err)
+	require.NoError(t, seedIfEmpty(context.Background(), stores, fixture))
+}
+
+// setupTestServiceWithStores creates a local gRPC server backed by the given stores
+func setupTestServiceWithStores(t *testing.T, stores *storeSet) *testService {
+	return setupTestServiceWithPolicy(t, stores, DeletePolicy{Mode: deleteModeReject})
+}
+
+// setupTestServiceWithPolicy creates a local gRPC server backed by the given
+// stores that applies deletePolicy to deleted users
+func setupTestServiceWithPolicy(t *testing.T, stores *storeSet, deletePolicy DeletePolicy) *testService {
 	// Create a buffer for gRPC connections
 	lis := bufconn.Listen(bufSize)
 
@@ -67,7 +109,7 @@ func setupTestService(t *testing.T) *testService {
 	grpcServer := grpc.NewServer()
 
 	// Register our service
-	service.RegisterUsersServiceServer(grpcServer, &UsersService{})
+	service.RegisterUsersServiceServer(grpcServer, NewUsersService(stores.users, stores.posts, stores.comments, stores.audit, stores.ids, deletePolicy))
 
 	// Start the server
 	go func() {
@@ -99,6 +141,7 @@ func setupTestService(t *testing.T) *testService {
 	return &testService{
 		grpcConn:    conn,
 		usersClient: client,
+		stores:      stores,
 		cleanup:     cleanup,
 	}
 }
@@ -302,7 +345,7 @@ func TestLookupUserById(t *testing.T) {
 						assert.Equal(t, len(want.SkillCategories.GetList().GetItems()), len(resp.Result[i].SkillCategories.GetList().GetItems()))
 					}
 					// Check RecentActivity against actual mock data
-					expectedActivities := userActivityMap[want.Id]
+					expectedActivities := svc.activity(t, want.Id)
 					verifyActivityContent(t, expectedActivities, resp.Result[i].RecentActivity, 0)
 					if want.Profile != nil {
 						assert.Equal(t, want.Profile.GetDisplayName(), resp.Result[i].Profile.GetDisplayName())
@@ -320,6 +363,68 @@ func TestLookupUserById(t *testing.T) {
 	}
 }
 
+func TestLookupPostById(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	ctx := context.Background()
+
+	_, err := svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{Input: &service.PostInput{
+		Title: "Draft", AuthorId: "1", Status: service.PostStatus_POST_STATUS_DRAFT,
+	}})
+	require.NoError(t, err)
+	charlie := metadata.AppendToOutgoingContext(ctx, actorMetadataKey, "3")
+	_, err = svc.usersClient.MutationDeletePost(charlie, &service.MutationDeletePostRequest{Id: "4"})
+	require.NoError(t, err)
+
+	lookup := func(t *testing.T, ctx context.Context, ids ...string) []*service.Post {
+		t.Helper()
+		req := &service.LookupPostByIdRequest{Keys: make([]*service.LookupPostByIdRequestKey, 0, len(ids))}
+		for _, id := range ids {
+			req.Keys = append(req.Keys, &service.LookupPostByIdRequestKey{Id: id})
+		}
+		resp, err := svc.usersClient.LookupPostById(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.Result, len(ids), "one result per key")
+		return resp.Result
+	}
+
+	// Results follow the order of the keys, with placeholders for unknown,
+	// deleted and hidden posts
+	posts := lookup(t, ctx, "3", "999", "1", "4", "5", "3")
+	assert.Equal(t, "Building Scalable APIs", posts[0].Title)
+	assert.Len(t, posts[0].Comments, 2)
+	assert.True(t, proto.Equal(&service.Post{Id: "999"}, posts[1]))
+	assert.Equal(t, "Getting Started with GraphQL", posts[2].Title)
+	assert.True(t, proto.Equal(&service.Post{Id: "4"}, posts[3]))
+	assert.True(t, proto.Equal(&service.Post{Id: "5"}, posts[4]))
+	assert.True(t, proto.Equal(posts[0], posts[5]))
+
+	// Authors resolve their own drafts
+	alice := metadata.AppendToOutgoingContext(ctx, actorMetadataKey, "1")
+	assert.Equal(t, "Draft", lookup(t, alice, "5")[0].Title)
+
+	assert.Empty(t, lookup(t, ctx))
+}
+
+func TestLookupCommentById(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	ctx := context.Background()
+
+	_, err := svc.usersClient.MutationDeleteComment(ctx, &service.MutationDeleteCommentRequest{Id: "2"})
+	require.NoError(t, err)
+
+	req := &service.LookupCommentByIdRequest{Keys: []*service.LookupCommentByIdRequestKey{{Id: "4"}, {Id: "2"}, {Id: "999"}, {Id: "1"}}}
+	resp, err := svc.usersClient.LookupCommentById(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Result, 4)
+	assert.Equal(t, "Excellent examples provided.", resp.Result[0].Content)
+	assert.Equal(t, "3", resp.Result[0].PostId)
+	assert.True(t, proto.Equal(&service.Comment{Id: "2"}, resp.Result[1]), "deleted comments are not found")
+	assert.True(t, proto.Equal(&service.Comment{Id: "999"}, resp.Result[2]))
+	assert.Equal(t, "Great post! Very helpful.", resp.Result[3].Content)
+}
+
 func TestQueryUser(t *testing.T) {
 	// Setup basic service - no need for HTTP mocks
 	svc := setupTestService(t)
@@ -356,8 +461,7 @@ func TestQueryUser(t *testing.T) {
 		{
 			name:    "nonexistent user",
 			id:      "999",
-			want:    &service.User{},
-			wantErr: false,
+			wantErr: true,
 		},
 	}
 
@@ -373,32 +477,27 @@ func TestQueryUser(t *testing.T) {
 
 			assert.NoError(t, err)
 
-			if tt.want.Name != "" { // Only check non-empty fields for valid users
-				assert.Equal(t, tt.want.Id, resp.User.Id)
-				assert.Equal(t, tt.want.Name, resp.User.Name)
-				assert.Equal(t, tt.want.Email, resp.User.Email)
-				assert.Equal(t, tt.want.Role, resp.User.Role)
-				assert.Equal(t, tt.want.Permissions, resp.User.Permissions)
-				if tt.want.Tags != nil {
-					assert.Equal(t, tt.want.Tags.GetList().GetItems(), resp.User.Tags.GetList().GetItems())
-				}
-				if tt.want.SkillCategories != nil {
-					assert.Equal(t, len(tt.want.SkillCategories.GetList().GetItems()), len(resp.User.SkillCategories.GetList().GetItems()))
-				}
-				// Check RecentActivity against actual mock data
-				expectedActivities := userActivityMap[tt.want.Id]
-				verifyActivityContent(t, expectedActivities, resp.User.RecentActivity, 0)
-				if tt.want.Profile != nil {
-					assert.Equal(t, tt.want.Profile.GetDisplayName(), resp.User.Profile.GetDisplayName())
-					assert.Equal(t, tt.want.Profile.GetTimezone(), resp.User.Profile.GetTimezone())
-					assert.Equal(t, tt.want.Profile.Theme, resp.User.Profile.Theme)
-				}
-				assert.Equal(t, tt.want.Bio.GetValue(), resp.User.Bio.GetValue())
-				assert.Equal(t, tt.want.Age.GetValue(), resp.User.Age.GetValue())
-			} else {
-				// For non-existent users, user should be nil
-				assert.Nil(t, resp.User)
+			assert.Equal(t, tt.want.Id, resp.User.Id)
+			assert.Equal(t, tt.want.Name, resp.User.Name)
+			assert.Equal(t, tt.want.Email, resp.User.Email)
+			assert.Equal(t, tt.want.Role, resp.User.Role)
+			assert.Equal(t, tt.want.Permissions, resp.User.Permissions)
+			if tt.want.Tags != nil {
+				assert.Equal(t, tt.want.Tags.GetList().GetItems(), resp.User.Tags.GetList().GetItems())
 			}
+			if tt.want.SkillCategories != nil {
+				assert.Equal(t, len(tt.want.SkillCategories.GetList().GetItems()), len(resp.User.SkillCategories.GetList().GetItems()))
+			}
+			// Check RecentActivity against actual mock data
+			expectedActivities := svc.activity(t, tt.want.Id)
+			verifyActivityContent(t, expectedActivities, resp.User.RecentActivity, 0)
+			if tt.want.Profile != nil {
+				assert.Equal(t, tt.want.Profile.GetDisplayName(), resp.User.Profile.GetDisplayName())
+				assert.Equal(t, tt.want.Profile.GetTimezone(), resp.User.Profile.GetTimezone())
+				assert.Equal(t, tt.want.Profile.Theme, resp.User.Profile.Theme)
+			}
+			assert.Equal(t, tt.want.Bio.GetValue(), resp.User.Bio.GetValue())
+			assert.Equal(t, tt.want.Age.GetValue(), resp.User.Age.GetValue())
 		})
 	}
 }
@@ -412,6 +511,9 @@ func TestQueryUsers(t *testing.T) {
 	resp, err := svc.usersClient.QueryUsers(context.Background(), req)
 
 	assert.NoError(t, err)
+
+	mockUsers, err := svc.stores.users.ListUsers(context.Background())
+	require.NoError(t, err)
 	assert.Equal(t, len(mockUsers), len(resp.Users))
 
 	// Create a map to check if each user is in the response
@@ -421,9 +523,9 @@ func TestQueryUsers(t *testing.T) {
 	}
 
 	// Verify each mock user is in the response
-	for id, mockUser := range mockUsers {
-		respUser, ok := responseMap[id]
-		assert.True(t, ok, "User %s should be in the response", id)
+	for _, mockUser := range mockUsers {
+		respUser, ok := responseMap[mockUser.Id]
+		assert.True(t, ok, "User %s should be in the response", mockUser.Id)
 		assert.Equal(t, mockUser.Id, respUser.Id)
 		assert.Equal(t, mockUser.Name, respUser.Name)
 		assert.Equal(t, mockUser.Email, respUser.Email)
@@ -436,7 +538,7 @@ func TestQueryUsers(t *testing.T) {
 			assert.Equal(t, len(mockUser.SkillCategories.GetList().GetItems()), len(respUser.SkillCategories.GetList().GetItems()))
 		}
 		// Check RecentActivity against actual mock data
-		expectedActivities := userActivityMap[mockUser.Id]
+		expectedActivities := svc.activity(t, mockUser.Id)
 		verifyActivityContent(t, expectedActivities, respUser.RecentActivity, 0)
 		if mockUser.Profile != nil {
 			assert.Equal(t, mockUser.Profile.DisplayName.GetValue(), respUser.Profile.DisplayName.GetValue())
@@ -448,6 +550,452 @@ func TestQueryUsers(t *testing.T) {
 	}
 }
 
+func TestQueryUsersOrderBy(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+
+	ctx := context.Background()
+
+	// A user without an age and with the same role and name as an existing one
+	require.NoError(t, svc.stores.users.SaveUser(ctx, &service.User{
+		Id:    "10",
+		Name:  "Bob Smith",
+		Email: "bob.smith@example.com",
+		Role:  service.UserRole_USER_ROLE_USER,
+	}))
+
+	// order builds the orderBy argument from field/direction pairs
+	order := func(orders ...*service.UserOrder) *service.ListOfUserOrder {
+		return &service.ListOfUserOrder{List: &service.ListOfUserOrder_List{Items: orders}}
+	}
+	asc := service.OrderDirection_ORDER_DIRECTION_ASC
+	desc := service.OrderDirection_ORDER_DIRECTION_DESC
+
+	tests := []struct {
+		name    string
+		orderBy *service.ListOfUserOrder
+		want    []string
+	}{
+		{
+			name: "default order is by ID",
+			want: []string{"1", "2", "3", "4", "10"},
+		},
+		{
+			name:    "by name with ID as tiebreaker",
+			orderBy: order(&service.UserOrder{Field: service.UserOrderField_USER_ORDER_FIELD_NAME}),
+			want:    []string{"1", "2", "10", "3", "4"},
+		},
+		{
+			name:    "by email descending",
+			orderBy: order(&service.UserOrder{Field: service.UserOrderField_USER_ORDER_FIELD_EMAIL, Direction: desc}),
+			want:    []string{"4", "3", "2", "10", "1"},
+		},
+		{
+			name:    "by age with missing ages last",
+			orderBy: order(&service.UserOrder{Field: service.UserOrderField_USER_ORDER_FIELD_AGE, Direction: asc}),
+			want:    []string{"4", "1", "3", "2", "10"},
+		},
+		{
+			name:    "by age descending with missing ages first",
+			orderBy: order(&service.UserOrder{Field: service.UserOrderField_USER_ORDER_FIELD_AGE, Direction: desc}),
+			want:    []string{"10", "2", "3", "1", "4"},
+		},
+		{
+			name: "by role then ID descending",
+			orderBy: order(
+				&service.UserOrder{Field: service.UserOrderField_USER_ORDER_FIELD_ROLE},
+				&service.UserOrder{Field: service.UserOrderField_USER_ORDER_FIELD_ID, Direction: desc},
+			),
+			want: []string{"1", "10", "3", "2", "4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The order must be the same on every call
+			for i := 0; i < 3; i++ {
+				resp, err := svc.usersClient.QueryUsers(ctx, &service.QueryUsersRequest{OrderBy: tt.orderBy})
+				require.NoError(t, err)
+
+				ids := make([]string, 0, len(resp.Users))
+				for _, user := range resp.Users {
+					ids = append(ids, user.Id)
+				}
+				assert.Equal(t, tt.want, ids)
+			}
+
+			// The paginated variant returns the same order, page by page
+			var ids []string
+			var after *wrapperspb.StringValue
+			for {
+				resp, err := svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{
+					First:   wrapperspb.Int32(2),
+					After:   after,
+					OrderBy: tt.orderBy,
+				})
+				require.NoError(t, err)
+				for _, edge := range resp.UsersConnection.Edges {
+					ids = append(ids, edge.Node.Id)
+				}
+				if !resp.UsersConnection.PageInfo.HasNextPage {
+					break
+				}
+				after = resp.UsersConnection.PageInfo.EndCursor
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+
+	t.Run("cursors are bound to their ordering", func(t *testing.T) {
+		byName := order(&service.UserOrder{Field: service.UserOrderField_USER_ORDER_FIELD_NAME})
+		resp, err := svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{
+			First:   wrapperspb.Int32(1),
+			OrderBy: byName,
+		})
+		require.NoError(t, err)
+
+		_, err = svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{
+			After: resp.UsersConnection.PageInfo.EndCursor,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects missing fields", func(t *testing.T) {
+		_, err := svc.usersClient.QueryUsers(ctx, &service.QueryUsersRequest{OrderBy: order(&service.UserOrder{})})
+		assert.Error(t, err)
+	})
+}
+
+func TestQueryUsersFilter(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+
+	ctx := context.Background()
+
+	// stringList builds a wrapped string list
+	stringList := func(items ...string) *service.ListOfString {
+		return &service.ListOfString{List: &service.ListOfString_List{Items: items}}
+	}
+	// filterList builds a wrapped filter list
+	filterList := func(items ...*service.UserFilter) *service.ListOfUserFilter {
+		return &service.ListOfUserFilter{List: &service.ListOfUserFilter_List{Items: items}}
+	}
+	// roleList builds a wrapped role list
+	roleList := func(items ...service.UserRole) *service.ListOfUserRole {
+		return &service.ListOfUserRole{List: &service.ListOfUserRole_List{Items: items}}
+	}
+
+	tests := []struct {
+		name   string
+		filter *service.UserFilter
+		want   []string
+	}{
+		{
+			name: "no filter",
+			want: []string{"1", "2", "3", "4"},
+		},
+		{
+			name:   "role_in",
+			filter: &service.UserFilter{RoleIn: roleList(service.UserRole_USER_ROLE_ADMIN, service.UserRole_USER_ROLE_GUEST)},
+			want:   []string{"1", "4"},
+		},
+		{
+			name:   "empty role_in matches nobody",
+			filter: &service.UserFilter{RoleIn: roleList()},
+			want:   []string{},
+		},
+		{
+			name:   "tags_contains_any",
+			filter: &service.UserFilter{TagsContainsAny: stringList("admin", "guest")},
+			want:   []string{"1", "4"},
+		},
+		{
+			name:   "permissions_contains_all",
+			filter: &service.UserFilter{PermissionsContainsAll: stringList("read", "write")},
+			want:   []string{"1"},
+		},
+		{
+			name:   "empty permissions_contains_all matches everybody",
+			filter: &service.UserFilter{PermissionsContainsAll: stringList()},
+			want:   []string{"1", "2", "3", "4"},
+		},
+		{
+			name:   "age range",
+			filter: &service.UserFilter{Age: &service.IntRange{Gt: wrapperspb.Int32(24), Lte: wrapperspb.Int32(29)}},
+			want:   []string{"1", "3"},
+		},
+		{
+			name:   "skill in any category, case-insensitive",
+			filter: &service.UserFilter{Skill: wrapperspb.String("kubernetes")},
+			want:   []string{"3"},
+		},
+		{
+			name: "conditions of one filter are combined with AND",
+			filter: &service.UserFilter{
+				RoleIn: roleList(service.UserRole_USER_ROLE_USER),
+				Age:    &service.IntRange{Gte: wrapperspb.Int32(30)},
+			},
+			want: []string{"2"},
+		},
+		{
+			name: "OR",
+			filter: &service.UserFilter{Or: filterList(
+				&service.UserFilter{Skill: wrapperspb.String("Go")},
+				&service.UserFilter{Skill: wrapperspb.String("Python")},
+			)},
+			want: []string{"2", "3"},
+		},
+		{
+			name:   "empty OR matches nobody",
+			filter: &service.UserFilter{Or: filterList()},
+			want:   []string{},
+		},
+		{
+			name:   "NOT",
+			filter: &service.UserFilter{Not: &service.UserFilter{TagsContainsAny: stringList("user")}},
+			want:   []string{"4"},
+		},
+		{
+			name: "nested AND, OR and NOT",
+			filter: &service.UserFilter{And: filterList(
+				&service.UserFilter{Or: filterList(
+					&service.UserFilter{RoleIn: roleList(service.UserRole_USER_ROLE_ADMIN)},
+					&service.UserFilter{Age: &service.IntRange{Lt: wrapperspb.Int32(30)}},
+				)},
+				&service.UserFilter{Not: &service.UserFilter{Skill: wrapperspb.String("HTML")}},
+			)},
+			want: []string{"1", "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := svc.usersClient.QueryUsers(ctx, &service.QueryUsersRequest{Filter: tt.filter})
+			require.NoError(t, err)
+
+			ids := make([]string, 0, len(resp.Users))
+			for _, user := range resp.Users {
+				ids = append(ids, user.Id)
+			}
+			assert.Equal(t, tt.want, ids)
+
+			// The paginated variant applies the same filter
+			conn, err := svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{Filter: tt.filter})
+			require.NoError(t, err)
+			assert.Equal(t, int32(len(tt.want)), conn.UsersConnection.TotalCount)
+			assert.Len(t, conn.UsersConnection.Edges, len(tt.want))
+		})
+	}
+
+	// Users without an age never match an age range
+	require.NoError(t, svc.stores.users.SaveUser(ctx, &service.User{Id: "5", Name: "Ageless"}))
+	resp, err := svc.usersClient.QueryUsers(ctx, &service.QueryUsersRequest{
+		Filter: &service.UserFilter{Not: &service.UserFilter{Age: &service.IntRange{}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Users, 1)
+	assert.Equal(t, "5", resp.Users[0].Id)
+}
+
+func TestQueryUsersConnection(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+
+	ctx := context.Background()
+
+	// Add users 5..12 so that numeric ordering ("10" after "9") matters
+	for i := 5; i <= 12; i++ {
+		id := fmt.Sprintf("%d", i)
+		require.NoError(t, svc.stores.users.SaveUser(ctx, &service.User{Id: id, Name: "User " + id}))
+	}
+
+	// edgeIDs returns the IDs of the users on a page
+	edgeIDs := func(conn *service.UserConnection) []string {
+		ids := make([]string, 0, len(conn.Edges))
+		for _, edge := range conn.Edges {
+			ids = append(ids, edge.Node.Id)
+		}
+		return ids
+	}
+
+	t.Run("pages forward with first and after", func(t *testing.T) {
+		var ids []string
+		var after *wrapperspb.StringValue
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, 10, "pagination must terminate")
+			resp, err := svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{
+				First: wrapperspb.Int32(5),
+				After: after,
+			})
+			require.NoError(t, err)
+
+			conn := resp.UsersConnection
+			assert.Equal(t, int32(12), conn.TotalCount)
+			assert.Equal(t, after != nil, conn.PageInfo.HasPreviousPage)
+			require.NotEmpty(t, conn.Edges)
+			assert.Equal(t, conn.Edges[0].Cursor, conn.PageInfo.StartCursor.GetValue())
+			assert.Equal(t, conn.Edges[len(conn.Edges)-1].Cursor, conn.PageInfo.EndCursor.GetValue())
+
+			ids = append(ids, edgeIDs(conn)...)
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			after = conn.PageInfo.EndCursor
+		}
+		assert.Equal(t, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}, ids)
+	})
+
+	t.Run("pages backward with last and before", func(t *testing.T) {
+		resp, err := svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{Last: wrapperspb.Int32(3)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"10", "11", "12"}, edgeIDs(resp.UsersConnection))
+		assert.True(t, resp.UsersConnection.PageInfo.HasPreviousPage)
+		assert.False(t, resp.UsersConnection.PageInfo.HasNextPage)
+
+		resp, err = svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{
+			Last:   wrapperspb.Int32(3),
+			Before: resp.UsersConnection.PageInfo.StartCursor,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"7", "8", "9"}, edgeIDs(resp.UsersConnection))
+		assert.True(t, resp.UsersConnection.PageInfo.HasNextPage)
+	})
+
+	t.Run("defaults to the first page", func(t *testing.T) {
+		resp, err := svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{})
+		require.NoError(t, err)
+		assert.Len(t, resp.UsersConnection.Edges, 12)
+		assert.False(t, resp.UsersConnection.PageInfo.HasNextPage)
+		assert.False(t, resp.UsersConnection.PageInfo.HasPreviousPage)
+	})
+
+	t.Run("cursors are stable across inserts", func(t *testing.T) {
+		resp, err := svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{First: wrapperspb.Int32(2)})
+		require.NoError(t, err)
+		endCursor := resp.UsersConnection.PageInfo.EndCursor
+
+		// A user that sorts before the cursor must not shift the next page
+		require.NoError(t, svc.stores.users.SaveUser(ctx, &service.User{Id: "0", Name: "User 0"}))
+
+		resp, err = svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{
+			First: wrapperspb.Int32(2),
+			After: endCursor,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"3", "4"}, edgeIDs(resp.UsersConnection))
+	})
+
+	t.Run("empty page keeps cursors unset", func(t *testing.T) {
+		resp, err := svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{First: wrapperspb.Int32(0)})
+		require.NoError(t, err)
+		assert.Empty(t, resp.UsersConnection.Edges)
+		assert.Nil(t, resp.UsersConnection.PageInfo.StartCursor)
+		assert.Nil(t, resp.UsersConnection.PageInfo.EndCursor)
+	})
+
+	t.Run("rejects invalid arguments", func(t *testing.T) {
+		invalid := []*service.QueryUsersConnectionRequest{
+			{First: wrapperspb.Int32(-1)},
+			{Last: wrapperspb.Int32(maxPageSize + 1)},
+			{First: wrapperspb.Int32(1), Last: wrapperspb.Int32(1)},
+			{After: wrapperspb.String("not a cursor")},
+			{Before: wrapperspb.String(encodeCursor("post", "1"))},
+		}
+		for _, req := range invalid {
+			_, err := svc.usersClient.QueryUsersConnection(ctx, req)
+			assert.Error(t, err, "request %v", req)
+		}
+	})
+}
+
+func TestQuerySearch(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+
+	ctx := context.Background()
+
+	// search returns the typename and ID of every result
+	search := func(req *service.QuerySearchRequest) []string {
+		t.Helper()
+		resp, err := svc.usersClient.QuerySearch(ctx, req)
+		require.NoError(t, err)
+
+		results := make([]string, 0, len(resp.Search))
+		for _, result := range resp.Search {
+			switch value := result.Value.(type) {
+			case *service.SearchResult_User:
+				results = append(results, "User:"+value.User.Id)
+			case *service.SearchResult_Post:
+				results = append(results, "Post:"+value.Post.Id)
+			case *service.SearchResult_Comment:
+				results = append(results, "Comment:"+value.Comment.Id)
+			}
+		}
+		return results
+	}
+
+	// Users, posts and comments are searched by their text fields
+	assert.Equal(t, []string{"User:1"}, search(&service.QuerySearchRequest{Query: "alice"}))
+	assert.Equal(t, []string{"User:2"}, search(&service.QuerySearchRequest{Query: "bob@example.com"}))
+	assert.Equal(t, []string{"Post:3"}, search(&service.QuerySearchRequest{Query: "scalable"}))
+	assert.Equal(t, []string{"Comment:4"}, search(&service.QuerySearchRequest{Query: "excellent examples"}))
+	assert.Empty(t, search(&service.QuerySearchRequest{Query: "nothing matches this"}))
+
+	// Types and first narrow down the results
+	assert.Equal(t, []string{"User:1", "User:2"}, search(&service.QuerySearchRequest{Query: "developer"}))
+	assert.Equal(t, []string{"User:1"}, search(&service.QuerySearchRequest{Query: "developer", First: wrapperspb.Int32(1)}))
+	assert.Empty(t, search(&service.QuerySearchRequest{
+		Query: "developer",
+		Types: &service.ListOfSearchableType{List: &service.ListOfSearchableType_List{Items: []service.SearchableType{service.SearchableType_SEARCHABLE_TYPE_POST}}},
+	}))
+
+	// Updated users are found by their new values only
+	_, err := svc.usersClient.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{
+		Input: &service.UserInput{Id: "3", Name: wrapperspb.String("Charlie Gopher")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"User:3"}, search(&service.QuerySearchRequest{Query: "gopher"}))
+	assert.Empty(t, search(&service.QuerySearchRequest{Query: "brown"}))
+
+	_, err = svc.usersClient.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{
+		Input: []*service.UserInput{{Id: "4", Bio: wrapperspb.String("Gopher in training")}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"User:3", "User:4"}, search(&service.QuerySearchRequest{Query: "gopher"}))
+
+	// Created posts are found right away; titles rank like names, above bios
+	created, err := svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{
+		Input: &service.PostInput{Title: "Gopher Tricks", AuthorId: "3"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{"User:3", "Post:" + created.CreatePost.Id, "User:4"},
+		search(&service.QuerySearchRequest{Query: "gopher"}),
+	)
+
+	// Drafts of other users are skipped before the limit applies, even when
+	// they rank above the visible matches
+	draft, err := svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{
+		Input: &service.PostInput{Title: "Quokka", AuthorId: "2", Status: service.PostStatus_POST_STATUS_DRAFT},
+	})
+	require.NoError(t, err)
+	published, err := svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{
+		Input: &service.PostInput{Title: "Quokkas in the Wild", AuthorId: "3"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Post:" + published.CreatePost.Id}, search(&service.QuerySearchRequest{Query: "quokka", First: wrapperspb.Int32(1)}))
+
+	bob := metadata.AppendToOutgoingContext(ctx, actorMetadataKey, "2")
+	resp, err := svc.usersClient.QuerySearch(bob, &service.QuerySearchRequest{Query: "quokka", First: wrapperspb.Int32(1)})
+	require.NoError(t, err)
+	require.Len(t, resp.Search, 1)
+	assert.Equal(t, draft.CreatePost.Id, resp.Search[0].GetPost().GetId())
+
+	// Invalid limits are rejected
+	_, err = svc.usersClient.QuerySearch(ctx, &service.QuerySearchRequest{Query: "alice", First: wrapperspb.Int32(-1)})
+	assert.Error(t, err)
+}
+
 func TestQueryExternalUsers(t *testing.T) {
 	// Setup service with HTTP mocks for external API
 	svc, _ := setupExternalTestService(t)
@@ -534,27 +1082,6 @@ func TestMutationUpdateUser(t *testing.T) {
 	svc := setupTestService(t)
 	defer svc.cleanup()
 
-	mu := mockUsers["1"]
-
-	// Store original user data to restore after test
-	origUser := &service.User{
-		Id:              mu.Id,
-		Name:            mu.Name,
-		Email:           mu.Email,
-		Role:            mu.Role,
-		Permissions:     mu.Permissions,
-		Tags:            mu.Tags,
-		SkillCategories: mu.SkillCategories,
-		RecentActivity:  mu.RecentActivity,
-		Profile:         mu.Profile,
-		Bio:             mu.Bio,
-		Age:             mu.Age,
-	}
-
-	defer func() {
-		mockUsers["1"] = origUser
-	}()
-
 	tests := []struct {
 		name    string
 		input   *service.UserInput
@@ -684,8 +1211,7 @@ func TestMutationUpdateUser(t *testing.T) {
 				Id:   "999",
 				Name: &wrapperspb.StringValue{Value: "Nonexistent User"},
 			},
-			want:    &service.User{},
-			wantErr: false,
+			wantErr: true,
 		},
 	}
 
@@ -703,8 +1229,7 @@ func TestMutationUpdateUser(t *testing.T) {
 
 			assert.NoError(t, err)
 
-			if tt.want.Name != "" { // Only check when we expect a valid response
-				assert.NotNil(t, resp.UpdateUser)
+			if assert.NotNil(t, resp.UpdateUser) {
 				assert.Equal(t, tt.want.Id, resp.UpdateUser.Id)
 				assert.Equal(t, tt.want.Name, resp.UpdateUser.Name)
 				assert.Equal(t, tt.want.Email, resp.UpdateUser.Email)
@@ -717,7 +1242,7 @@ func TestMutationUpdateUser(t *testing.T) {
 					assert.Equal(t, len(tt.want.SkillCategories.GetList().GetItems()), len(resp.UpdateUser.SkillCategories.GetList().GetItems()))
 				}
 				// Check RecentActivity against actual mock data
-				expectedActivities := userActivityMap[tt.want.Id]
+				expectedActivities := svc.activity(t, tt.want.Id)
 				verifyActivityContent(t, expectedActivities, resp.UpdateUser.RecentActivity, 0)
 				if tt.want.Profile != nil {
 					assert.Equal(t, tt.want.Profile.GetDisplayName(), resp.UpdateUser.Profile.GetDisplayName())
@@ -726,48 +1251,458 @@ func TestMutationUpdateUser(t *testing.T) {
 				}
 				assert.Equal(t, tt.want.Bio.GetValue(), resp.UpdateUser.Bio.GetValue())
 				assert.Equal(t, tt.want.Age.GetValue(), resp.UpdateUser.Age.GetValue())
-			} else {
-				// For nonexistent users, expect empty response
-				assert.Nil(t, resp.UpdateUser)
 			}
 		})
 	}
 }
 
-func TestMutationUpdateUsers(t *testing.T) {
-	// Setup basic service - no need for HTTP mocks
+func TestMutationUpdateUserNullSemantics(t *testing.T) {
 	svc := setupTestService(t)
 	defer svc.cleanup()
+	ctx := context.Background()
+
+	update := func(t *testing.T, input *service.UserInput) *service.User {
+		t.Helper()
+		input.Id = "1"
+		resp, err := svc.usersClient.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: input})
+		require.NoError(t, err)
+		require.NotNil(t, resp.UpdateUser)
+		return resp.UpdateUser
+	}
+
+	t.Run("absent fields keep their value", func(t *testing.T) {
+		user := update(t, &service.UserInput{})
+		assert.Equal(t, "Full-stack developer with 5+ years of experience", user.Bio.GetValue())
+		assert.Equal(t, []string{"admin", "user"}, user.Tags.GetList().GetItems())
+		assert.Equal(t, int32(28), user.Age.GetValue())
+		assert.Equal(t, "Alice J.", user.Profile.GetDisplayName().GetValue())
+	})
+
+	t.Run("empty values are set", func(t *testing.T) {
+		user := update(t, &service.UserInput{
+			Bio:         wrapperspb.String(""),
+			Tags:        &service.ListOfString{List: &service.ListOfString_List{}},
+			Permissions: &service.ListOfString{List: &service.ListOfString_List{}},
+			Profile:     &service.ProfileInput{DisplayName: wrapperspb.String("")},
+		})
+		require.NotNil(t, user.Bio)
+		assert.Equal(t, "", user.Bio.Value)
+		require.NotNil(t, user.Tags)
+		assert.Empty(t, user.Tags.GetList().GetItems())
+		assert.Empty(t, user.Permissions)
+		require.NotNil(t, user.Profile.DisplayName)
+		assert.Equal(t, "", user.Profile.DisplayName.Value)
+		assert.Equal(t, "America/New_York", user.Profile.GetTimezone().GetValue())
+	})
+
+	t.Run("unset fields become null", func(t *testing.T) {
+		user := update(t, &service.UserInput{
+			Unset: &service.ListOfNullableUserField{List: &service.ListOfNullableUserField_List{Items: []service.NullableUserField{
+				service.NullableUserField_NULLABLE_USER_FIELD_BIO,
+				service.NullableUserField_NULLABLE_USER_FIELD_TAGS,
+				service.NullableUserField_NULLABLE_USER_FIELD_AGE,
+			}}},
+			Profile: &service.ProfileInput{
+				Unset: &service.ListOfNullableProfileField{List: &service.ListOfNullableProfileField_List{Items: []service.NullableProfileField{
+					service.NullableProfileField_NULLABLE_PROFILE_FIELD_DISPLAY_NAME,
+					service.NullableProfileField_NULLABLE_PROFILE_FIELD_THEME,
+				}}},
+			},
+		})
+		assert.Nil(t, user.Bio)
+		assert.Nil(t, user.Tags)
+		assert.Nil(t, user.Age)
+		require.NotNil(t, user.Profile)
+		assert.Nil(t, user.Profile.DisplayName)
+		assert.Equal(t, service.Theme_THEME_UNSPECIFIED, user.Profile.Theme)
+		assert.Equal(t, "America/New_York", user.Profile.GetTimezone().GetValue())
+
+		// The change is persisted, not only returned
+		stored, err := svc.stores.users.GetUser(ctx, "1")
+		require.NoError(t, err)
+		assert.Nil(t, stored.Bio)
+		assert.Nil(t, stored.Tags)
+	})
+
+	t.Run("unset profile", func(t *testing.T) {
+		user := update(t, &service.UserInput{
+			Unset: &service.ListOfNullableUserField{List: &service.ListOfNullableUserField_List{Items: []service.NullableUserField{
+				service.NullableUserField_NULLABLE_USER_FIELD_PROFILE,
+			}}},
+		})
+		assert.Nil(t, user.Profile)
+	})
+
+	t.Run("set and unset at the same time", func(t *testing.T) {
+		inputs := []*service.UserInput{
+			{
+				Id:  "1",
+				Bio: wrapperspb.String("Back again"),
+				Unset: &service.ListOfNullableUserField{List: &service.ListOfNullableUserField_List{Items: []service.NullableUserField{
+					service.NullableUserField_NULLABLE_USER_FIELD_BIO,
+				}}},
+			},
+			{
+				Id: "1",
+				Profile: &service.ProfileInput{
+					Timezone: wrapperspb.String("Europe/Berlin"),
+					Unset: &service.ListOfNullableProfileField{List: &service.ListOfNullableProfileField_List{Items: []service.NullableProfileField{
+						service.NullableProfileField_NULLABLE_PROFILE_FIELD_TIMEZONE,
+					}}},
+				},
+			},
+		}
+		for _, input := range inputs {
+			_, err := svc.usersClient.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: input})
+			assert.Error(t, err)
+		}
+
+		// In a batch, only the contradictory input is rejected
+		resp, err := svc.usersClient.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{Input: []*service.UserInput{
+			{Id: "2", Bio: wrapperspb.String("Still saved")},
+			inputs[0],
+		}})
+		require.NoError(t, err)
+		require.Len(t, resp.UpdateUsers.GetResults(), 2)
+		assert.Equal(t, "bio", resp.UpdateUsers.Results[1].GetValidationFailed().GetField().GetValue())
+		stored, err := svc.stores.users.GetUser(ctx, "2")
+		require.NoError(t, err)
+		assert.Equal(t, "Still saved", stored.Bio.GetValue())
+	})
+}
+
+func TestMutationCreateUser(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+
+	ctx := context.Background()
+
+	// Create a user with only the required fields
+	resp, err := svc.usersClient.MutationCreateUser(ctx, &service.MutationCreateUserRequest{
+		Input: &service.CreateUserInput{
+			Name:  "Erin Example",
+			Email: "erin@example.com",
+			Role:  service.UserRole_USER_ROLE_USER,
+		},
+	})
+	require.NoError(t, err)
+	created := resp.CreateUser
+	assert.Equal(t, "5", created.Id, "IDs continue after the seeded users")
+	assert.Equal(t, "Erin Example", created.Name)
+	assert.Empty(t, created.Permissions)
+	assert.NotNil(t, created.SkillCategories)
+	assert.Nil(t, created.Tags)
+	assert.Nil(t, created.Profile)
+
+	// The user can be queried and has no activity yet
+	userResp, err := svc.usersClient.QueryUser(ctx, &service.QueryUserRequest{Id: created.Id})
+	require.NoError(t, err)
+	assert.Equal(t, "erin@example.com", userResp.User.Email)
+	assert.Empty(t, userResp.User.RecentActivity)
+
+	// Create a user with all fields
+	resp, err = svc.usersClient.MutationCreateUser(ctx, &service.MutationCreateUserRequest{
+		Input: &service.CreateUserInput{
+			Name:        "Frank Full",
+			Email:       "frank@example.com",
+			Role:        service.UserRole_USER_ROLE_ADMIN,
+			Permissions: &service.ListOfString{List: &service.ListOfString_List{Items: []string{"read", "write"}}},
+			Tags:        &service.ListOfString{List: &service.ListOfString_List{Items: []string{"admin"}}},
+			SkillCategories: &service.ListOfListOfString{List: &service.ListOfListOfString_List{Items: []*service.ListOfString{
+				{List: &service.ListOfString_List{Items: []string{"Go"}}},
+			}}},
+			Bio: wrapperspb.String("Writes Go"),
+			Age: wrapperspb.Int32(40),
+			Profile: &service.ProfileInput{
+				DisplayName: wrapperspb.String("Frank"),
+				Theme:       service.Theme_THEME_DARK,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "6", resp.CreateUser.Id)
+
+	stored, err := svc.stores.users.GetUser(ctx, "6")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"read", "write"}, stored.Permissions)
+	assert.Equal(t, []string{"admin"}, stored.Tags.GetList().GetItems())
+	assert.Equal(t, "Go", stored.SkillCategories.GetList().GetItems()[0].GetList().GetItems()[0])
+	assert.Equal(t, "Writes Go", stored.Bio.GetValue())
+	assert.Equal(t, int32(40), stored.Age.GetValue())
+	assert.Equal(t, "Frank", stored.Profile.GetDisplayName().GetValue())
+	assert.Equal(t, service.Theme_THEME_DARK, stored.Profile.Theme)
+
+	// New users are searchable and can author posts
+	searchResp, err := svc.usersClient.QuerySearch(ctx, &service.QuerySearchRequest{Query: "frank"})
+	require.NoError(t, err)
+	require.Len(t, searchResp.Search, 1)
+	assert.Equal(t, "6", searchResp.Search[0].GetUser().GetId())
+
+	_, err = svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{
+		Input: &service.PostInput{Title: "Hello", AuthorId: "6"},
+	})
+	require.NoError(t, err)
+
+	// A missing input is rejected
+	_, err = svc.usersClient.MutationCreateUser(ctx, &service.MutationCreateUserRequest{})
+	assert.Error(t, err)
+}
+
+func TestMutationDeleteUser(t *testing.T) {
+	ctx := context.Background()
 
-	// Store original user data to restore after test
-	origUsers := make(map[string]*service.User)
-	for id, user := range mockUsers {
-		userCopy := &service.User{
-			Id:              user.Id,
-			Name:            user.Name,
-			Email:           user.Email,
-			Role:            user.Role,
-			Permissions:     user.Permissions,
-			Tags:            user.Tags,
-			SkillCategories: user.SkillCategories,
-			RecentActivity:  user.RecentActivity,
-			Profile:         user.Profile,
-			Bio:             user.Bio,
-			Age:             user.Age,
+	// newService creates an isolated service that applies the given policy
+	newService := func(t *testing.T, policy DeletePolicy) *testService {
+		stores, err := openStore(&Config{StoreBackend: storeBackendMemory})
+		require.NoError(t, err)
+		seedTestStores(t, stores)
+		return setupTestServiceWithPolicy(t, stores, policy)
+	}
+
+	// deleteUser deletes a user and returns the deleted user
+	deleteUser := func(t *testing.T, svc *testService, id string) (*service.User, error) {
+		resp, err := svc.usersClient.MutationDeleteUser(ctx, &service.MutationDeleteUserRequest{Id: id})
+		if err != nil {
+			return nil, err
 		}
-		origUsers[id] = userCopy
+		return resp.DeleteUser, nil
 	}
-	defer func() {
-		for id, user := range origUsers {
-			mockUsers[id] = user
+
+	t.Run("unknown users are not found", func(t *testing.T) {
+		svc := newService(t, DeletePolicy{Mode: deleteModeCascade})
+		defer svc.cleanup()
+
+		_, err := deleteUser(t, svc, "999")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("reject refuses users with activity", func(t *testing.T) {
+		svc := newService(t, DeletePolicy{Mode: deleteModeReject})
+		defer svc.cleanup()
+
+		_, err := deleteUser(t, svc, "1")
+		assert.Error(t, err)
+
+		// Nothing was deleted
+		_, err = svc.stores.users.GetUser(ctx, "1")
+		assert.NoError(t, err)
+		_, err = svc.stores.posts.GetPost(ctx, "1")
+		assert.NoError(t, err)
+
+		// Users without activity can be deleted
+		created, err := svc.usersClient.MutationCreateUser(ctx, &service.MutationCreateUserRequest{
+			Input: &service.CreateUserInput{Name: "Temp", Email: "temp@example.com", Role: service.UserRole_USER_ROLE_GUEST},
+		})
+		require.NoError(t, err)
+		user, err := deleteUser(t, svc, created.CreateUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "Temp", user.Name)
+	})
+
+	t.Run("cascade deletes posts and comments", func(t *testing.T) {
+		svc := newService(t, DeletePolicy{Mode: deleteModeCascade})
+		defer svc.cleanup()
+
+		user, err := deleteUser(t, svc, "1")
+		require.NoError(t, err)
+		assert.Equal(t, "Alice Johnson", user.Name)
+		assert.Len(t, user.RecentActivity, 3, "the deleted user is returned as it was")
+
+		_, err = svc.usersClient.QueryUser(ctx, &service.QueryUserRequest{Id: "1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		for _, id := range []string{"1", "2"} {
+			_, err = svc.stores.posts.GetPost(ctx, id)
+			assert.ErrorIs(t, err, ErrNotFound, "post %s", id)
 		}
-	}()
+		_, err = svc.stores.comments.GetComment(ctx, "4")
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		// Posts of other users are kept
+		_, err = svc.stores.posts.GetPost(ctx, "3")
+		assert.NoError(t, err)
+
+		// Deleted records are no longer searchable
+		searchResp, err := svc.usersClient.QuerySearch(ctx, &service.QuerySearchRequest{Query: "graphql alice"})
+		require.NoError(t, err)
+		assert.Empty(t, searchResp.Search)
+
+		// New posts don't reuse the IDs of deleted posts
+		created, err := svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{
+			Input: &service.PostInput{Title: "After cascade", AuthorId: "2"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "5", created.CreatePost.Id)
+	})
+
+	t.Run("reassign hands activity to another user", func(t *testing.T) {
+		svc := newService(t, DeletePolicy{Mode: deleteModeReassign, ReassignTo: "4"})
+		defer svc.cleanup()
+
+		user, err := deleteUser(t, svc, "1")
+		require.NoError(t, err)
+		assert.Equal(t, "1", user.RecentActivity[0].GetComment().GetAuthorId(), "the deleted user is returned as it was")
+
+		post, err := svc.stores.posts.GetPost(ctx, "1")
+		require.NoError(t, err)
+		assert.Equal(t, "4", post.AuthorId)
+
+		// The reassigned activity is merged with Dana's own comment by creation time
+		activity := svc.activity(t, "4")
+		require.Len(t, activity, 4)
+		assert.Equal(t, "4", activity[0].GetComment().GetId())
+		assert.Equal(t, "3", activity[1].GetComment().GetId())
+		assert.Equal(t, "2", activity[2].GetPost().GetId())
+		assert.Equal(t, "1", activity[3].GetPost().GetId())
+
+		// The user that inherits activity cannot be deleted itself
+		_, err = deleteUser(t, svc, "4")
+		assert.Error(t, err)
+	})
+
+	t.Run("reassign fails when the target doesn't exist", func(t *testing.T) {
+		svc := newService(t, DeletePolicy{Mode: deleteModeReassign, ReassignTo: "999"})
+		defer svc.cleanup()
+
+		_, err := deleteUser(t, svc, "1")
+		assert.Error(t, err)
+		_, err = svc.stores.users.GetUser(ctx, "1")
+		assert.NoError(t, err)
+	})
+}
+
+func TestSoftDelete(t *testing.T) {
+	ctx := context.Background()
+	stores, err := openStore(&Config{StoreBackend: storeBackendMemory})
+	require.NoError(t, err)
+	seedTestStores(t, stores)
+	svc := setupTestServiceWithPolicy(t, stores, DeletePolicy{Mode: deleteModeCascade})
+	defer svc.cleanup()
+
+	// Post 2 is deleted on its own before its author
+	require.NoError(t, stores.posts.DeletePost(ctx, "2", time.Now().Add(-time.Hour)))
+
+	resp, err := svc.usersClient.MutationDeleteUser(ctx, &service.MutationDeleteUserRequest{Id: "1"})
+	require.NoError(t, err)
+	deletedAt := resp.DeleteUser.GetDeletedAt().GetValue()
+	require.NotEmpty(t, deletedAt)
+	assert.Equal(t, int32(2), resp.DeleteUser.Version)
+
+	t.Run("deleted users are hidden by default", func(t *testing.T) {
+		_, err := svc.usersClient.QueryUser(ctx, &service.QueryUserRequest{Id: "1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = svc.usersClient.QueryUserActivity(ctx, &service.QueryUserActivityRequest{UserId: "1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		usersResp, err := svc.usersClient.QueryUsers(ctx, &service.QueryUsersRequest{})
+		require.NoError(t, err)
+		assert.Len(t, usersResp.Users, 3)
+
+		connResp, err := svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), connResp.UsersConnection.TotalCount)
+
+		lookupResp, err := svc.usersClient.LookupUserById(ctx, &service.LookupUserByIdRequest{Keys: []*service.LookupUserByIdRequestKey{{Id: "1"}}})
+		require.NoError(t, err)
+		assert.Equal(t, &service.User{Id: "1"}, lookupResp.Result[0], "deleted users resolve to a placeholder")
+
+		// Deleting a user again fails like deleting an unknown user
+		_, err = svc.usersClient.MutationDeleteUser(ctx, &service.MutationDeleteUserRequest{Id: "1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = svc.usersClient.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: &service.UserInput{Id: "1", Name: wrapperspb.String("Ghost")}})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("includeDeleted is only available to admins", func(t *testing.T) {
+		charlie := metadata.AppendToOutgoingContext(ctx, actorMetadataKey, "3")
+		for _, caller := range []context.Context{ctx, charlie} {
+			_, err := svc.usersClient.QueryUser(caller, &service.QueryUserRequest{Id: "1", IncludeDeleted: wrapperspb.Bool(true)})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			_, err = svc.usersClient.QueryUsers(caller, &service.QueryUsersRequest{IncludeDeleted: wrapperspb.Bool(true)})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			_, err = svc.usersClient.QueryUsersConnection(caller, &service.QueryUsersConnectionRequest{IncludeDeleted: wrapperspb.Bool(true)})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			_, err = svc.usersClient.QueryUserActivity(caller, &service.QueryUserActivityRequest{UserId: "1", IncludeDeleted: wrapperspb.Bool(true)})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			_, err = svc.usersClient.QueryUserActivityConnection(caller, &service.QueryUserActivityConnectionRequest{UserId: "1", IncludeDeleted: wrapperspb.Bool(true)})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		}
+	})
+
+	t.Run("includeDeleted shows tombstones", func(t *testing.T) {
+		// The seeded admin has been deleted, so Bob takes over
+		bob, err := stores.users.GetUser(ctx, "2")
+		require.NoError(t, err)
+		bob.Role = service.UserRole_USER_ROLE_ADMIN
+		require.NoError(t, stores.users.SaveUser(ctx, bob))
+		ctx := metadata.AppendToOutgoingContext(ctx, actorMetadataKey, "2")
+
+		userResp, err := svc.usersClient.QueryUser(ctx, &service.QueryUserRequest{Id: "1", IncludeDeleted: wrapperspb.Bool(true)})
+		require.NoError(t, err)
+		assert.Equal(t, deletedAt, userResp.User.GetDeletedAt().GetValue())
+
+		usersResp, err := svc.usersClient.QueryUsers(ctx, &service.QueryUsersRequest{IncludeDeleted: wrapperspb.Bool(true)})
+		require.NoError(t, err)
+		assert.Len(t, usersResp.Users, 4)
+
+		connResp, err := svc.usersClient.QueryUsersConnection(ctx, &service.QueryUsersConnectionRequest{IncludeDeleted: wrapperspb.Bool(true)})
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), connResp.UsersConnection.TotalCount)
+
+		activityConnResp, err := svc.usersClient.QueryUserActivityConnection(ctx, &service.QueryUserActivityConnectionRequest{UserId: "1", IncludeDeleted: wrapperspb.Bool(true)})
+		require.NoError(t, err)
+		assert.Len(t, activityConnResp.UserActivityConnection.Edges, 3)
+
+		activityResp, err := svc.usersClient.QueryUserActivity(ctx, &service.QueryUserActivityRequest{UserId: "1", IncludeDeleted: wrapperspb.Bool(true)})
+		require.NoError(t, err)
+		require.Len(t, activityResp.UserActivity, 3)
+		assert.Equal(t, deletedAt, activityResp.UserActivity[0].GetComment().GetDeletedAt().GetValue())
+		assert.NotEqual(t, deletedAt, activityResp.UserActivity[1].GetPost().GetDeletedAt().GetValue(), "post 2 keeps its own tombstone")
+		assert.Equal(t, deletedAt, activityResp.UserActivity[2].GetPost().GetDeletedAt().GetValue())
+	})
+
+	t.Run("restore brings back what was deleted with the user", func(t *testing.T) {
+		restoreResp, err := svc.usersClient.MutationRestoreUser(ctx, &service.MutationRestoreUserRequest{Id: "1"})
+		require.NoError(t, err)
+		user := restoreResp.RestoreUser
+		assert.Nil(t, user.DeletedAt)
+		assert.Equal(t, int32(3), user.Version)
+
+		// Post 2 was deleted before the user, so it stays deleted
+		require.Len(t, user.RecentActivity, 2)
+		assert.Equal(t, "4", user.RecentActivity[0].GetComment().GetId())
+		assert.Equal(t, "1", user.RecentActivity[1].GetPost().GetId())
+		_, err = stores.posts.GetPost(ctx, "2")
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		// Restored records are searchable again
+		searchResp, err := svc.usersClient.QuerySearch(ctx, &service.QuerySearchRequest{Query: "getting started"})
+		require.NoError(t, err)
+		require.Len(t, searchResp.Search, 1)
+		assert.Equal(t, "1", searchResp.Search[0].GetPost().GetId())
+
+		// Restoring a user that isn't deleted returns it unchanged
+		again, err := svc.usersClient.MutationRestoreUser(ctx, &service.MutationRestoreUserRequest{Id: "1"})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), again.RestoreUser.Version)
+
+		_, err = svc.usersClient.MutationRestoreUser(ctx, &service.MutationRestoreUserRequest{Id: "999"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestMutationUpdateUsers(t *testing.T) {
+	// Setup basic service - no need for HTTP mocks
+	svc := setupTestService(t)
+	defer svc.cleanup()
 
 	tests := []struct {
-		name    string
-		inputs  []*service.UserInput
-		want    []*service.User
-		wantErr bool
+		name         string
+		inputs       []*service.UserInput
+		want         []*service.User
+		wantNotFound []string
+		wantErr      bool
 	}{
 		{
 			name: "update multiple valid users",
@@ -860,7 +1795,8 @@ func TestMutationUpdateUsers(t *testing.T) {
 					Age: &wrapperspb.Int32Value{Value: 29},
 				},
 			},
-			wantErr: false,
+			wantNotFound: []string{"999"},
+			wantErr:      false,
 		},
 		{
 			name:    "empty input",
@@ -875,8 +1811,9 @@ func TestMutationUpdateUsers(t *testing.T) {
 					Name: &wrapperspb.StringValue{Value: "Missing ID User"},
 				},
 			},
-			want:    []*service.User{},
-			wantErr: false,
+			want:         []*service.User{},
+			wantNotFound: []string{""},
+			wantErr:      false,
 		},
 	}
 
@@ -893,7 +1830,24 @@ func TestMutationUpdateUsers(t *testing.T) {
 			}
 
 			assert.NoError(t, err)
-			assert.Equal(t, len(tt.want), len(resp.UpdateUsers))
+
+			// Every input has a result, in the order of the inputs
+			results := resp.UpdateUsers.GetResults()
+			require.Len(t, results, len(tt.inputs))
+			var updatedUsers []*service.User
+			var notFound []string
+			for _, result := range results {
+				switch value := result.Value.(type) {
+				case *service.UpdateUserResult_UpdatedUser:
+					updatedUsers = append(updatedUsers, value.UpdatedUser.User)
+				case *service.UpdateUserResult_UserNotFound:
+					notFound = append(notFound, value.UserNotFound.Id)
+				default:
+					t.Errorf("unexpected result %v", result)
+				}
+			}
+			assert.Equal(t, len(tt.want), len(updatedUsers))
+			assert.Equal(t, tt.wantNotFound, notFound)
 
 			// Create a map of expected users by ID for easier comparison
 			expectedUsers := make(map[string]*service.User)
@@ -902,7 +1856,7 @@ func TestMutationUpdateUsers(t *testing.T) {
 			}
 
 			// Check each returned user against expected values
-			for _, updatedUser := range resp.UpdateUsers {
+			for _, updatedUser := range updatedUsers {
 				expected, ok := expectedUsers[updatedUser.Id]
 				assert.True(t, ok, "User %s should be in the expected results", updatedUser.Id)
 				assert.Equal(t, expected.Id, updatedUser.Id)
@@ -917,7 +1871,7 @@ func TestMutationUpdateUsers(t *testing.T) {
 					assert.Equal(t, len(expected.SkillCategories.GetList().GetItems()), len(updatedUser.SkillCategories.GetList().GetItems()))
 				}
 				// Check RecentActivity against actual mock data
-				expectedActivities := userActivityMap[expected.Id]
+				expectedActivities := svc.activity(t, expected.Id)
 				verifyActivityContent(t, expectedActivities, updatedUser.RecentActivity, 0)
 				if expected.Profile != nil {
 					assert.Equal(t, expected.Profile.GetDisplayName(), updatedUser.Profile.GetDisplayName())
@@ -931,6 +1885,103 @@ func TestMutationUpdateUsers(t *testing.T) {
 	}
 }
 
+func TestMutationUpdateUsersAtomic(t *testing.T) {
+	ctx := context.Background()
+	atomic := wrapperspb.Bool(true)
+
+	t.Run("all inputs valid", func(t *testing.T) {
+		svc := setupTestService(t)
+		defer svc.cleanup()
+
+		resp, err := svc.usersClient.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{
+			Atomic: atomic,
+			Input: []*service.UserInput{
+				{Id: "1", Name: wrapperspb.String("Alice Atomic")},
+				{Id: "2", Age: wrapperspb.Int32(41)},
+				{Id: "1", Email: wrapperspb.String("alice.atomic@example.com")},
+			},
+		})
+		require.NoError(t, err)
+		results := resp.UpdateUsers.GetResults()
+		require.Len(t, results, 3)
+		// Each result shows the user right after its own input
+		assert.Equal(t, "Alice Atomic", results[0].GetUpdatedUser().GetUser().GetName())
+		assert.Equal(t, "alice@example.com", results[0].GetUpdatedUser().GetUser().GetEmail())
+		assert.Equal(t, int32(41), results[1].GetUpdatedUser().GetUser().GetAge().GetValue())
+		assert.Equal(t, "alice.atomic@example.com", results[2].GetUpdatedUser().GetUser().GetEmail())
+
+		// The second input for user 1 builds on the first one
+		alice, err := svc.stores.users.GetUser(ctx, "1")
+		require.NoError(t, err)
+		assert.Equal(t, "Alice Atomic", alice.Name)
+		assert.Equal(t, "alice.atomic@example.com", alice.Email)
+	})
+
+	t.Run("failing inputs reject the batch", func(t *testing.T) {
+		svc := setupTestService(t)
+		defer svc.cleanup()
+
+		resp, err := svc.usersClient.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{
+			Atomic: atomic,
+			Input: []*service.UserInput{
+				{Id: "1", Name: wrapperspb.String("Alice Atomic")},
+				{Id: "999", Name: wrapperspb.String("Nobody")},
+				{Id: "2", Bio: wrapperspb.String("Bio"), Unset: &service.ListOfNullableUserField{List: &service.ListOfNullableUserField_List{Items: []service.NullableUserField{
+					service.NullableUserField_NULLABLE_USER_FIELD_BIO,
+				}}}},
+			},
+		})
+		require.NoError(t, err)
+		results := resp.UpdateUsers.GetResults()
+		require.Len(t, results, 3)
+
+		notApplied := results[0].GetValidationFailed()
+		require.NotNil(t, notApplied)
+		assert.Equal(t, "1", notApplied.Id)
+		assert.Nil(t, notApplied.Field)
+
+		notFound := results[1].GetUserNotFound()
+		require.NotNil(t, notFound)
+		assert.Equal(t, "999", notFound.Id)
+		assert.Equal(t, `user "999" not found`, notFound.Message)
+
+		invalid := results[2].GetValidationFailed()
+		require.NotNil(t, invalid)
+		assert.Equal(t, "2", invalid.Id)
+		assert.Equal(t, "bio", invalid.Field.GetValue())
+
+		alice, err := svc.stores.users.GetUser(ctx, "1")
+		require.NoError(t, err)
+		assert.Equal(t, "Alice Johnson", alice.Name)
+	})
+
+	t.Run("non-atomic batch applies valid inputs", func(t *testing.T) {
+		svc := setupTestService(t)
+		defer svc.cleanup()
+
+		resp, err := svc.usersClient.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{
+			Atomic: wrapperspb.Bool(false),
+			Input: []*service.UserInput{
+				{Id: "1", Name: wrapperspb.String("Alice Updated")},
+				{Id: "999", Name: wrapperspb.String("Nobody")},
+				{Id: "2", Age: wrapperspb.Int32(1), Unset: &service.ListOfNullableUserField{List: &service.ListOfNullableUserField_List{Items: []service.NullableUserField{
+					service.NullableUserField_NULLABLE_USER_FIELD_AGE,
+				}}}},
+			},
+		})
+		require.NoError(t, err)
+		results := resp.UpdateUsers.GetResults()
+		require.Len(t, results, 3)
+		assert.Equal(t, "Alice Updated", results[0].GetUpdatedUser().GetUser().GetName())
+		assert.NotNil(t, results[1].GetUserNotFound())
+		assert.Equal(t, "age", results[2].GetValidationFailed().GetField().GetValue())
+
+		alice, err := svc.stores.users.GetUser(ctx, "1")
+		require.NoError(t, err)
+		assert.Equal(t, "Alice Updated", alice.Name)
+	})
+}
+
 func TestQueryUserActivity(t *testing.T) {
 	// Setup basic service
 	svc := setupTestService(t)
@@ -964,7 +2015,7 @@ func TestQueryUserActivity(t *testing.T) {
 			name:    "nonexistent user",
 			userId:  "999",
 			limit:   10,
-			wantErr: false,
+			wantErr: true,
 		},
 	}
 
@@ -984,7 +2035,7 @@ func TestQueryUserActivity(t *testing.T) {
 			assert.NoError(t, err)
 
 			// Get expected activities from mock data and verify content
-			expectedActivities := userActivityMap[tt.userId]
+			expectedActivities := svc.activity(t, tt.userId)
 			expectedLen := len(expectedActivities)
 
 			// If limit is specified and > 0, use the minimum of limit and expected length
@@ -997,13 +2048,435 @@ func TestQueryUserActivity(t *testing.T) {
 	}
 }
 
+func TestQueryUserActivityTimeRange(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	ctx := context.Background()
+
+	// Alice posted on 2024-01-15 and 2024-02-03 and commented on 2024-04-02
+	tests := []struct {
+		name  string
+		since string
+		until string
+		limit int32
+		want  []string
+	}{
+		{name: "unbounded", want: []string{"comment 4", "post 2", "post 1"}},
+		{name: "since", since: "2024-02-01T00:00:00Z", want: []string{"comment 4", "post 2"}},
+		{name: "until is exclusive", until: "2024-02-03T14:30:00Z", want: []string{"post 1"}},
+		{name: "since is inclusive and takes offsets", since: "2024-02-03T15:30:00+01:00", until: "2024-04-01T00:00:00Z", want: []string{"post 2"}},
+		{name: "limit applies after the range", since: "2024-01-01T00:00:00Z", limit: 1, want: []string{"comment 4"}},
+		{name: "empty range", since: "2025-01-01T00:00:00Z", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &service.QueryUserActivityRequest{UserId: "1", Limit: wrapperspb.Int32(tt.limit)}
+			if tt.since != "" {
+				req.Since = wrapperspb.String(tt.since)
+			}
+			if tt.until != "" {
+				req.Until = wrapperspb.String(tt.until)
+			}
+			resp, err := svc.usersClient.QueryUserActivity(ctx, req)
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(resp.UserActivity))
+			for _, item := range resp.UserActivity {
+				if post := item.GetPost(); post != nil {
+					got = append(got, "post "+post.Id)
+				} else {
+					got = append(got, "comment "+item.GetComment().GetId())
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("invalid ranges", func(t *testing.T) {
+		_, err := svc.usersClient.QueryUserActivity(ctx, &service.QueryUserActivityRequest{UserId: "1", Since: wrapperspb.String("2024-02-01")})
+		assert.Contains(t, fieldViolations(t, err), "since")
+
+		_, err = svc.usersClient.QueryUserActivity(ctx, &service.QueryUserActivityRequest{
+			UserId: "1",
+			Since:  wrapperspb.String("2024-02-01T00:00:00Z"),
+			Until:  wrapperspb.String("2024-02-01T00:00:00Z"),
+		})
+		assert.Equal(t, "must be after since", fieldViolations(t, err)["until"])
+	})
+}
+
+func TestQueryUserActivityConnection(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	ctx := context.Background()
+
+	activityPage := func(t *testing.T, req *service.QueryUserActivityConnectionRequest) (*service.ActivityConnection, []string) {
+		t.Helper()
+		resp, err := svc.usersClient.QueryUserActivityConnection(ctx, req)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(resp.UserActivityConnection.Edges))
+		for _, edge := range resp.UserActivityConnection.Edges {
+			if post := edge.Node.GetPost(); post != nil {
+				ids = append(ids, "post "+post.Id)
+			} else {
+				ids = append(ids, "comment "+edge.Node.GetComment().GetId())
+			}
+		}
+		return resp.UserActivityConnection, ids
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		first, ids := activityPage(t, &service.QueryUserActivityConnectionRequest{UserId: "1", First: wrapperspb.Int32(2)})
+		assert.Equal(t, []string{"comment 4", "post 2"}, ids)
+		assert.Equal(t, int32(3), first.TotalCount)
+		assert.True(t, first.PageInfo.HasNextPage)
+
+		rest, ids := activityPage(t, &service.QueryUserActivityConnectionRequest{UserId: "1", First: wrapperspb.Int32(2), After: first.PageInfo.EndCursor})
+		assert.Equal(t, []string{"post 1"}, ids)
+		assert.False(t, rest.PageInfo.HasNextPage)
+		assert.True(t, rest.PageInfo.HasPreviousPage)
+	})
+
+	t.Run("types", func(t *testing.T) {
+		posts, ids := activityPage(t, &service.QueryUserActivityConnectionRequest{UserId: "1", Types: &service.ListOfActivityType{
+			List: &service.ListOfActivityType_List{Items: []service.ActivityType{service.ActivityType_ACTIVITY_TYPE_POST}},
+		}})
+		assert.Equal(t, []string{"post 2", "post 1"}, ids)
+		assert.Equal(t, int32(2), posts.TotalCount)
+
+		_, ids = activityPage(t, &service.QueryUserActivityConnectionRequest{UserId: "1", Types: &service.ListOfActivityType{
+			List: &service.ListOfActivityType_List{Items: []service.ActivityType{service.ActivityType_ACTIVITY_TYPE_COMMENT}},
+		}})
+		assert.Equal(t, []string{"comment 4"}, ids)
+
+		// An empty list selects nothing
+		_, ids = activityPage(t, &service.QueryUserActivityConnectionRequest{UserId: "1", Types: &service.ListOfActivityType{}})
+		assert.Empty(t, ids)
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		_, ids := activityPage(t, &service.QueryUserActivityConnectionRequest{UserId: "1", Until: wrapperspb.String("2024-03-01T00:00:00Z")})
+		assert.Equal(t, []string{"post 2", "post 1"}, ids)
+	})
+
+	t.Run("cursors are stable when activity is added", func(t *testing.T) {
+		first, _ := activityPage(t, &service.QueryUserActivityConnectionRequest{UserId: "1", First: wrapperspb.Int32(1)})
+		_, err := svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{Input: &service.PostInput{Title: "Newest", AuthorId: "1"}})
+		require.NoError(t, err)
+
+		_, ids := activityPage(t, &service.QueryUserActivityConnectionRequest{UserId: "1", After: first.PageInfo.EndCursor})
+		assert.Equal(t, []string{"post 2", "post 1"}, ids)
+		_, ids = activityPage(t, &service.QueryUserActivityConnectionRequest{UserId: "1", First: wrapperspb.Int32(1)})
+		assert.Equal(t, []string{"post 5"}, ids)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := svc.usersClient.QueryUserActivityConnection(ctx, &service.QueryUserActivityConnectionRequest{UserId: "999"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = svc.usersClient.QueryUserActivityConnection(ctx, &service.QueryUserActivityConnectionRequest{UserId: "1", First: wrapperspb.Int32(maxPageSize + 1)})
+		assert.Contains(t, fieldViolations(t, err), "first")
+
+		// Cursors of other connections are rejected
+		_, err = svc.usersClient.QueryUserActivityConnection(ctx, &service.QueryUserActivityConnectionRequest{UserId: "1", After: wrapperspb.String(encodeCursor("user", "1"))})
+		assert.Contains(t, fieldViolations(t, err), "after")
+		_, err = svc.usersClient.QueryUserActivityConnection(ctx, &service.QueryUserActivityConnectionRequest{UserId: "1", After: wrapperspb.String(encodeCursor("activity", ""))})
+		assert.Contains(t, fieldViolations(t, err), "after")
+	})
+}
+
+func TestQueryPosts(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	ctx := context.Background()
+
+	postsPage := func(t *testing.T, req *service.QueryPostsRequest) (*service.PostConnection, []string) {
+		t.Helper()
+		resp, err := svc.usersClient.QueryPosts(ctx, req)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(resp.Posts.Edges))
+		for _, edge := range resp.Posts.Edges {
+			ids = append(ids, edge.Node.Id)
+		}
+		return resp.Posts, ids
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		first, ids := postsPage(t, &service.QueryPostsRequest{First: wrapperspb.Int32(3)})
+		assert.Equal(t, []string{"4", "3", "2"}, ids)
+		assert.Equal(t, int32(4), first.TotalCount)
+		assert.True(t, first.PageInfo.HasNextPage)
+		assert.Len(t, first.Edges[1].Node.Comments, 2, "posts carry their comments")
+
+		rest, ids := postsPage(t, &service.QueryPostsRequest{First: wrapperspb.Int32(3), After: first.PageInfo.EndCursor})
+		assert.Equal(t, []string{"1"}, ids)
+		assert.False(t, rest.PageInfo.HasNextPage)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		connection, ids := postsPage(t, &service.QueryPostsRequest{Filter: &service.PostFilter{AuthorId: wrapperspb.String("1")}})
+		assert.Equal(t, []string{"2", "1"}, ids)
+		assert.Equal(t, int32(2), connection.TotalCount)
+
+		_, ids = postsPage(t, &service.QueryPostsRequest{Filter: &service.PostFilter{StatusIn: &service.ListOfPostStatus{
+			List: &service.ListOfPostStatus_List{Items: []service.PostStatus{service.PostStatus_POST_STATUS_DRAFT}},
+		}}})
+		assert.Empty(t, ids)
+	})
+
+	t.Run("post", func(t *testing.T) {
+		resp, err := svc.usersClient.QueryPost(ctx, &service.QueryPostRequest{Id: "3"})
+		require.NoError(t, err)
+		assert.Equal(t, "2", resp.Post.AuthorId)
+		assert.Equal(t, service.PostStatus_POST_STATUS_PUBLISHED, resp.Post.Status)
+
+		_, err = svc.usersClient.QueryPost(ctx, &service.QueryPostRequest{Id: "999"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, resourcePost, errorDetail[*errdetails.ResourceInfo](t, err).ResourceType)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := svc.usersClient.QueryPosts(ctx, &service.QueryPostsRequest{First: wrapperspb.Int32(-1)})
+		assert.Contains(t, fieldViolations(t, err), "first")
+
+		// Cursors of other connections are rejected
+		_, err = svc.usersClient.QueryPosts(ctx, &service.QueryPostsRequest{After: wrapperspb.String(encodeCursor("user", "1"))})
+		assert.Contains(t, fieldViolations(t, err), "after")
+		_, err = svc.usersClient.QueryPosts(ctx, &service.QueryPostsRequest{After: wrapperspb.String(encodeCursor("post", ""))})
+		assert.Contains(t, fieldViolations(t, err), "after")
+	})
+}
+
+func TestPostStatus(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	anonymous := context.Background()
+	alice := metadata.AppendToOutgoingContext(context.Background(), actorMetadataKey, "1")
+	bob := metadata.AppendToOutgoingContext(context.Background(), actorMetadataKey, "2")
+
+	created, err := svc.usersClient.MutationCreatePost(alice, &service.MutationCreatePostRequest{Input: &service.PostInput{
+		Title: "Unfinished thoughts", AuthorId: "1", Status: service.PostStatus_POST_STATUS_DRAFT,
+	}})
+	require.NoError(t, err)
+	draft := created.CreatePost
+	assert.Equal(t, service.PostStatus_POST_STATUS_DRAFT, draft.Status)
+
+	// shown reports whether the draft appears anywhere the given caller can read posts
+	shown := func(t *testing.T, ctx context.Context) map[string]bool {
+		t.Helper()
+		result := make(map[string]bool)
+
+		activity, err := svc.usersClient.QueryUserActivity(ctx, &service.QueryUserActivityRequest{UserId: "1"})
+		require.NoError(t, err)
+		result["userActivity"] = slices.ContainsFunc(activity.UserActivity, func(item *service.ActivityItem) bool {
+			return item.GetPost().GetId() == draft.Id
+		})
+
+		user, err := svc.usersClient.QueryUser(ctx, &service.QueryUserRequest{Id: "1"})
+		require.NoError(t, err)
+		result["recentActivity"] = slices.ContainsFunc(user.User.RecentActivity, func(item *service.ActivityItem) bool {
+			return item.GetPost().GetId() == draft.Id
+		})
+
+		posts, err := svc.usersClient.QueryPosts(ctx, &service.QueryPostsRequest{})
+		require.NoError(t, err)
+		result["posts"] = slices.ContainsFunc(posts.Posts.Edges, func(edge *service.PostEdge) bool {
+			return edge.Node.Id == draft.Id
+		})
+
+		_, err = svc.usersClient.QueryPost(ctx, &service.QueryPostRequest{Id: draft.Id})
+		result["post"] = err == nil
+
+		search, err := svc.usersClient.QuerySearch(ctx, &service.QuerySearchRequest{Query: "unfinished"})
+		require.NoError(t, err)
+		result["search"] = len(search.Search) > 0
+		return result
+	}
+	everywhere := map[string]bool{"userActivity": true, "recentActivity": true, "posts": true, "post": true, "search": true}
+	nowhere := map[string]bool{"userActivity": false, "recentActivity": false, "posts": false, "post": false, "search": false}
+
+	// Drafts are only shown to their author
+	assert.Equal(t, everywhere, shown(t, alice))
+	assert.Equal(t, nowhere, shown(t, bob))
+	assert.Equal(t, nowhere, shown(t, anonymous))
+
+	// Once published, everyone sees the post
+	_, err = svc.usersClient.MutationUpdatePost(alice, &service.MutationUpdatePostRequest{Input: &service.UpdatePostInput{
+		Id: draft.Id, Status: service.PostStatus_POST_STATUS_PUBLISHED,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, everywhere, shown(t, bob))
+	assert.Equal(t, everywhere, shown(t, anonymous))
+
+	// Archived posts are hidden from other users again, but kept for the author
+	_, err = svc.usersClient.MutationUpdatePost(alice, &service.MutationUpdatePostRequest{Input: &service.UpdatePostInput{
+		Id: draft.Id, Status: service.PostStatus_POST_STATUS_ARCHIVED,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, nowhere, shown(t, bob))
+	assert.Equal(t, everywhere, shown(t, alice))
+
+	// Other users can't change or comment on hidden posts either
+	_, err = svc.usersClient.MutationUpdatePost(bob, &service.MutationUpdatePostRequest{Input: &service.UpdatePostInput{
+		Id: draft.Id, Title: wrapperspb.String("Hijacked"),
+	}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.usersClient.MutationDeletePost(bob, &service.MutationDeletePostRequest{Id: draft.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.usersClient.MutationCreateComment(bob, &service.MutationCreateCommentRequest{Input: &service.CommentInput{
+		Content: "Found it", AuthorId: "2", PostId: draft.Id,
+	}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, everywhere, shown(t, alice))
+
+	comment, err := svc.usersClient.MutationCreateComment(alice, &service.MutationCreateCommentRequest{Input: &service.CommentInput{
+		Content: "Note to self", AuthorId: "1", PostId: draft.Id,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, draft.Id, comment.CreateComment.PostId)
+
+	// Users returned by mutations only list the posts the caller can see
+	hasDraft := func(user *service.User) bool {
+		return slices.ContainsFunc(user.RecentActivity, func(item *service.ActivityItem) bool {
+			return item.GetPost().GetId() == draft.Id
+		})
+	}
+	updateUser := func(ctx context.Context) *service.User {
+		t.Helper()
+		resp, err := svc.usersClient.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: &service.UserInput{Id: "1"}})
+		require.NoError(t, err)
+		return resp.UpdateUser
+	}
+	assert.True(t, hasDraft(updateUser(alice)))
+	assert.False(t, hasDraft(updateUser(bob)))
+	restored, err := svc.usersClient.MutationRestoreUser(bob, &service.MutationRestoreUserRequest{Id: "1"})
+	require.NoError(t, err)
+	assert.False(t, hasDraft(restored.RestoreUser))
+
+	// Comments on hidden posts are hidden from entity lookups as well
+	lookup := func(ctx context.Context) *service.Comment {
+		t.Helper()
+		resp, err := svc.usersClient.LookupCommentById(ctx, &service.LookupCommentByIdRequest{
+			Keys: []*service.LookupCommentByIdRequestKey{{Id: comment.CreateComment.Id}},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Result, 1)
+		return resp.Result[0]
+	}
+	assert.Equal(t, "Note to self", lookup(alice).Content)
+	assert.True(t, proto.Equal(&service.Comment{Id: comment.CreateComment.Id}, lookup(bob)))
+
+	// and from every other read, and other users can't change them
+	activity, err := svc.usersClient.QueryUserActivity(bob, &service.QueryUserActivityRequest{UserId: "1"})
+	require.NoError(t, err)
+	for _, item := range activity.UserActivity {
+		assert.NotEqual(t, comment.CreateComment.Id, item.GetComment().GetId())
+	}
+	search, err := svc.usersClient.QuerySearch(bob, &service.QuerySearchRequest{Query: "note to self"})
+	require.NoError(t, err)
+	assert.Empty(t, search.Search)
+	_, err = svc.usersClient.MutationUpdateComment(bob, &service.MutationUpdateCommentRequest{Input: &service.UpdateCommentInput{
+		Id: comment.CreateComment.Id, Content: "Edited",
+	}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.usersClient.MutationDeleteComment(bob, &service.MutationDeleteCommentRequest{Id: comment.CreateComment.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Hidden posts still count as activity when deleting their author
+	_, err = svc.usersClient.MutationDeleteUser(bob, &service.MutationDeleteUserRequest{Id: "1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestMutationUpdatePost(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	// Posts can only be changed by their author
+	ctx := metadata.AppendToOutgoingContext(context.Background(), actorMetadataKey, "1")
+
+	history := func(t *testing.T, userID string) []*service.AuditRecord {
+		t.Helper()
+		resp, err := svc.usersClient.QueryUserHistory(ctx, &service.QueryUserHistoryRequest{UserId: userID})
+		require.NoError(t, err)
+		records := make([]*service.AuditRecord, 0, len(resp.UserHistory.Edges))
+		for _, edge := range resp.UserHistory.Edges {
+			records = append(records, edge.Node)
+		}
+		return records
+	}
+
+	// Editing a post keeps its creation time, status and comments
+	updated, err := svc.usersClient.MutationUpdatePost(ctx, &service.MutationUpdatePostRequest{Input: &service.UpdatePostInput{
+		Id: "1", Title: wrapperspb.String("GraphQL for Beginners"),
+	}})
+	require.NoError(t, err)
+	post := updated.UpdatePost
+	assert.Equal(t, "GraphQL for Beginners", post.Title)
+	assert.Equal(t, service.PostStatus_POST_STATUS_PUBLISHED, post.Status)
+	assert.Equal(t, "2024-01-15T09:00:00.000Z", post.CreatedAt)
+	assert.Greater(t, post.UpdatedAt, post.CreatedAt)
+	assert.Len(t, post.Comments, 2)
+
+	records := history(t, "1")
+	require.Len(t, records, 1)
+	assert.Equal(t, service.AuditAction_AUDIT_ACTION_UPDATE_POST, records[0].Action)
+	assert.Equal(t, map[string][2]string{"id": {`"1"`, `"1"`}, "title": {`"Getting Started with GraphQL"`, `"GraphQL for Beginners"`}}, auditChanges(records[0]))
+
+	// Inputs that change nothing are not saved
+	unchanged, err := svc.usersClient.MutationUpdatePost(ctx, &service.MutationUpdatePostRequest{Input: &service.UpdatePostInput{
+		Id: "1", Title: wrapperspb.String("GraphQL for Beginners"), Status: service.PostStatus_POST_STATUS_PUBLISHED,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, post.UpdatedAt, unchanged.UpdatePost.UpdatedAt)
+	assert.Len(t, history(t, "1"), 1)
+
+	// Other users and anonymous requests can't change the post
+	bob := metadata.AppendToOutgoingContext(context.Background(), actorMetadataKey, "2")
+	_, err = svc.usersClient.MutationUpdatePost(bob, &service.MutationUpdatePostRequest{Input: &service.UpdatePostInput{
+		Id: "1", Status: service.PostStatus_POST_STATUS_ARCHIVED,
+	}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, "NOT_AUTHOR", errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+	_, err = svc.usersClient.MutationDeletePost(context.Background(), &service.MutationDeletePostRequest{Id: "1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Len(t, history(t, "1"), 1)
+
+	// Deleted posts disappear from the activity and can no longer be changed
+	deleted, err := svc.usersClient.MutationDeletePost(ctx, &service.MutationDeletePostRequest{Id: "1"})
+	require.NoError(t, err)
+	require.NotNil(t, deleted.DeletePost.DeletedAt)
+	assert.Equal(t, "GraphQL for Beginners", deleted.DeletePost.Title)
+	for _, item := range svc.activity(t, "1") {
+		assert.NotEqual(t, "1", item.GetPost().GetId())
+	}
+	_, err = svc.usersClient.QueryPost(ctx, &service.QueryPostRequest{Id: "1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svc.usersClient.MutationUpdatePost(ctx, &service.MutationUpdatePostRequest{Input: &service.UpdatePostInput{
+		Id: "1", Title: wrapperspb.String("Too late"),
+	}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.usersClient.MutationDeletePost(ctx, &service.MutationDeletePostRequest{Id: "1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	records = history(t, "1")
+	require.Len(t, records, 2)
+	assert.Equal(t, service.AuditAction_AUDIT_ACTION_DELETE_POST, records[0].Action)
+	assert.Equal(t, [2]string{"null", `"` + deleted.DeletePost.DeletedAt.Value + `"`}, auditChanges(records[0])["deletedAt"])
+
+	// Comments on a deleted post are left untouched
+	comment, err := svc.stores.comments.GetComment(ctx, "1")
+	require.NoError(t, err)
+	assert.Nil(t, comment.DeletedAt)
+}
+
 func TestMutationCreatePost(t *testing.T) {
 	// Setup basic service
 	svc := setupTestService(t)
 	defer svc.cleanup()
 
 	// Store original user activity count to verify the post was added
-	originalActivityCount := len(userActivityMap["1"])
+	originalActivityCount := len(svc.activity(t, "1"))
 
 	tests := []struct {
 		name    string
@@ -1054,6 +2527,10 @@ func TestMutationCreatePost(t *testing.T) {
 			assert.Equal(t, tt.input.Title, resp.CreatePost.Title)
 			assert.Equal(t, tt.input.AuthorId, resp.CreatePost.AuthorId)
 			assert.NotEmpty(t, resp.CreatePost.Id)
+			createdAt, err := time.Parse(time.RFC3339, resp.CreatePost.CreatedAt)
+			require.NoError(t, err)
+			assert.WithinDuration(t, time.Now(), createdAt, time.Minute)
+			assert.Equal(t, resp.CreatePost.CreatedAt, resp.CreatePost.UpdatedAt)
 
 			// Verify the post was added to the author's recent activity
 			userResp, err := svc.usersClient.QueryUser(context.Background(), &service.QueryUserRequest{Id: tt.input.AuthorId})
@@ -1072,7 +2549,7 @@ func TestMutationCreatePost(t *testing.T) {
 	}
 
 	// Verify user 1's activity count increased by 1 (only 1 valid post for user 1)
-	newActivityCount := len(userActivityMap["1"])
+	newActivityCount := len(svc.activity(t, "1"))
 	assert.Equal(t, originalActivityCount+1, newActivityCount)
 }
 
@@ -1150,3 +2627,212 @@ func TestCreatePostIntegration(t *testing.T) {
 
 	t.Logf("Verified new post also appears via QueryUserActivity endpoint")
 }
+
+func TestMutationComments(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	ctx := context.Background()
+
+	postComments := func(t *testing.T, postID string) []string {
+		t.Helper()
+		post, err := svc.stores.posts.GetPost(ctx, postID)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(post.Comments))
+		for _, comment := range post.Comments {
+			ids = append(ids, comment.Id)
+		}
+		return ids
+	}
+
+	// A new comment is listed last under its post and leads the activity of its author
+	created, err := svc.usersClient.MutationCreateComment(ctx, &service.MutationCreateCommentRequest{Input: &service.CommentInput{
+		PostId: "3", AuthorId: "2", Content: "Answering my own post",
+	}})
+	require.NoError(t, err)
+	comment := created.CreateComment
+	assert.Equal(t, "5", comment.Id)
+	assert.Equal(t, "3", comment.PostId)
+	assert.Equal(t, "2", comment.AuthorId)
+	assert.Equal(t, comment.CreatedAt, comment.UpdatedAt)
+	assert.Equal(t, []string{"3", "4", "5"}, postComments(t, "3"))
+
+	activity := svc.activity(t, "2")
+	require.NotEmpty(t, activity)
+	assert.Equal(t, "5", activity[0].GetComment().GetId())
+	for _, item := range activity {
+		if post := item.GetPost(); post != nil && post.Id == "3" {
+			require.Len(t, post.Comments, 3)
+			assert.Equal(t, "Answering my own post", post.Comments[2].Content)
+		}
+	}
+
+	// Comments on unknown posts or by unknown users are rejected
+	_, err = svc.usersClient.MutationCreateComment(ctx, &service.MutationCreateCommentRequest{Input: &service.CommentInput{
+		PostId: "999", AuthorId: "2", Content: "Lost",
+	}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, resourcePost, errorDetail[*errdetails.ResourceInfo](t, err).ResourceType)
+	_, err = svc.usersClient.MutationCreateComment(ctx, &service.MutationCreateCommentRequest{Input: &service.CommentInput{
+		PostId: "3", AuthorId: "999", Content: "Anonymous",
+	}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, resourceUser, errorDetail[*errdetails.ResourceInfo](t, err).ResourceType)
+
+	// Editing a comment keeps its creation time and its position
+	updated, err := svc.usersClient.MutationUpdateComment(ctx, &service.MutationUpdateCommentRequest{Input: &service.UpdateCommentInput{
+		Id: "3", Content: "Edited",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "Edited", updated.UpdateComment.Content)
+	assert.Equal(t, "2024-03-18T11:40:00.000Z", updated.UpdateComment.CreatedAt)
+	assert.Greater(t, updated.UpdateComment.UpdatedAt, updated.UpdateComment.CreatedAt)
+	assert.Equal(t, []string{"3", "4", "5"}, postComments(t, "3"))
+
+	// Deleted comments disappear from their post and can no longer be changed
+	deleted, err := svc.usersClient.MutationDeleteComment(ctx, &service.MutationDeleteCommentRequest{Id: "3"})
+	require.NoError(t, err)
+	require.NotNil(t, deleted.DeleteComment.DeletedAt)
+	assert.Equal(t, "Edited", deleted.DeleteComment.Content)
+	assert.Equal(t, []string{"4", "5"}, postComments(t, "3"))
+	assert.Empty(t, svc.activity(t, "4"))
+
+	_, err = svc.usersClient.MutationUpdateComment(ctx, &service.MutationUpdateCommentRequest{Input: &service.UpdateCommentInput{
+		Id: "3", Content: "Too late",
+	}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, resourceComment, errorDetail[*errdetails.ResourceInfo](t, err).ResourceType)
+	_, err = svc.usersClient.MutationDeleteComment(ctx, &service.MutationDeleteCommentRequest{Id: "3"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The changes are recorded in the history of the authors
+	history, err := svc.usersClient.QueryUserHistory(ctx, &service.QueryUserHistoryRequest{UserId: "4"})
+	require.NoError(t, err)
+	require.Len(t, history.UserHistory.Edges, 2)
+	deleteRecord, updateRecord := history.UserHistory.Edges[0].Node, history.UserHistory.Edges[1].Node
+	assert.Equal(t, service.AuditAction_AUDIT_ACTION_DELETE_COMMENT, deleteRecord.Action)
+	assert.Equal(t, [2]string{"null", `"` + deleted.DeleteComment.DeletedAt.Value + `"`}, auditChanges(deleteRecord)["deletedAt"])
+	assert.Equal(t, service.AuditAction_AUDIT_ACTION_UPDATE_COMMENT, updateRecord.Action)
+	assert.Equal(t, map[string][2]string{"id": {`"3"`, `"3"`}, "content": {`"Looking forward to more content."`, `"Edited"`}}, auditChanges(updateRecord))
+
+	history, err = svc.usersClient.QueryUserHistory(ctx, &service.QueryUserHistoryRequest{UserId: "2"})
+	require.NoError(t, err)
+	require.Len(t, history.UserHistory.Edges, 1)
+	assert.Equal(t, service.AuditAction_AUDIT_ACTION_CREATE_COMMENT, history.UserHistory.Edges[0].Node.Action)
+	assert.Equal(t, [2]string{"null", `"3"`}, auditChanges(history.UserHistory.Edges[0].Node)["postId"])
+}
+
+func TestUsersServiceStoreIsolation(t *testing.T) {
+	// Setup two services, each with its own store
+	first := setupTestService(t)
+	defer first.cleanup()
+	second := setupTestService(t)
+	defer second.cleanup()
+
+	// Update a user through the first service only
+	_, err := first.usersClient.MutationUpdateUser(context.Background(), &service.MutationUpdateUserRequest{
+		Input: &service.UserInput{
+			Id:   "1",
+			Name: &wrapperspb.StringValue{Value: "Alice Isolated"},
+		},
+	})
+	require.NoError(t, err)
+
+	firstResp, err := first.usersClient.QueryUser(context.Background(), &service.QueryUserRequest{Id: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Isolated", firstResp.User.Name)
+
+	// The second service must still see the seed data
+	secondResp, err := second.usersClient.QueryUser(context.Background(), &service.QueryUserRequest{Id: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Johnson", secondResp.User.Name)
+}
+
+func TestMutationUpdateUserVersion(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	ctx := context.Background()
+
+	update := func(input *service.UserInput) (*service.User, error) {
+		resp, err := svc.usersClient.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: input})
+		return resp.GetUpdateUser(), err
+	}
+
+	userResp, err := svc.usersClient.QueryUser(ctx, &service.QueryUserRequest{Id: "1"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), userResp.User.Version)
+	_, err = time.Parse(time.RFC3339, userResp.User.UpdatedAt)
+	require.NoError(t, err)
+
+	t.Run("changes advance the version", func(t *testing.T) {
+		user, err := update(&service.UserInput{Id: "1", Name: wrapperspb.String("Alice v2"), ExpectedVersion: wrapperspb.Int32(1)})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), user.Version)
+		assert.GreaterOrEqual(t, user.UpdatedAt, userResp.User.UpdatedAt)
+	})
+
+	t.Run("updates without changes keep the version", func(t *testing.T) {
+		user, err := update(&service.UserInput{Id: "1", Name: wrapperspb.String("Alice v2")})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), user.Version)
+	})
+
+	t.Run("outdated expected version", func(t *testing.T) {
+		_, err := update(&service.UserInput{Id: "1", Name: wrapperspb.String("Lost update"), ExpectedVersion: wrapperspb.Int32(1)})
+		require.Equal(t, codes.Aborted, status.Code(err), "error: %v", err)
+		info := errorDetail[*errdetails.ErrorInfo](t, err)
+		assert.Equal(t, "VERSION_CONFLICT", info.Reason)
+		assert.Equal(t, map[string]string{"id": "1", "expectedVersion": "1", "currentVersion": "2"}, info.Metadata)
+
+		user, err := svc.stores.users.GetUser(ctx, "1")
+		require.NoError(t, err)
+		assert.Equal(t, "Alice v2", user.Name)
+	})
+
+	t.Run("invalid expected version", func(t *testing.T) {
+		_, err := update(&service.UserInput{Id: "1", ExpectedVersion: wrapperspb.Int32(0)})
+		assert.Contains(t, fieldViolations(t, err), "input.expectedVersion")
+	})
+
+	t.Run("created users start at version 1", func(t *testing.T) {
+		resp, err := svc.usersClient.MutationCreateUser(ctx, &service.MutationCreateUserRequest{Input: &service.CreateUserInput{
+			Name: "Dana", Email: "dana@example.com", Role: service.UserRole_USER_ROLE_USER,
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), resp.CreateUser.Version)
+		assert.NotEmpty(t, resp.CreateUser.UpdatedAt)
+	})
+}
+
+func TestMutationUpdateUsersVersion(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	ctx := context.Background()
+
+	resp, err := svc.usersClient.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{Input: []*service.UserInput{
+		{Id: "1", Name: wrapperspb.String("Alice v2"), ExpectedVersion: wrapperspb.Int32(1)},
+		{Id: "1", Age: wrapperspb.Int32(29), ExpectedVersion: wrapperspb.Int32(1)},
+		{Id: "2", Name: wrapperspb.String("Bob v2"), ExpectedVersion: wrapperspb.Int32(4)},
+		{Id: "3", Name: wrapperspb.String("Charlie Brown")},
+	}})
+	require.NoError(t, err)
+	results := resp.UpdateUsers.GetResults()
+	require.Len(t, results, 4)
+
+	// Both inputs for user 1 expect the version before the batch, which is saved once
+	assert.Equal(t, int32(2), results[0].GetUpdatedUser().GetUser().GetVersion())
+	assert.Equal(t, int32(2), results[1].GetUpdatedUser().GetUser().GetVersion())
+	assert.NotEmpty(t, results[1].GetUpdatedUser().GetUser().GetUpdatedAt())
+
+	conflict := results[2].GetVersionConflict()
+	require.NotNil(t, conflict)
+	assert.Equal(t, "2", conflict.Id)
+	assert.Equal(t, int32(4), conflict.ExpectedVersion)
+	assert.Equal(t, int32(1), conflict.CurrentVersion)
+
+	// Inputs that change nothing keep the version
+	assert.Equal(t, int32(1), results[3].GetUpdatedUser().GetUser().GetVersion())
+
+	bob, err := svc.stores.users.GetUser(ctx, "2")
+	require.NoError(t, err)
+	assert.Equal(t, "Bob Smith", bob.Name)
+}
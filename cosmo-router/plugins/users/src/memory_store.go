@@ -0,0 +1,434 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Interface guards to ensure that MemoryStore implements all store interfaces
+var (
+	_ UserStore    = (*MemoryStore)(nil)
+	_ PostStore    = (*MemoryStore)(nil)
+	_ CommentStore = (*MemoryStore)(nil)
+	_ AuditStore   = (*MemoryStore)(nil)
+)
+
+// MemoryStore keeps users, posts, comments and the audit log in process memory.
+// All data is lost when the plugin process exits.
+//
+// MemoryStore is safe for concurrent use. Records are cloned when they are
+// stored and when they are returned, so callers can freely modify the values
+// they receive without affecting the store or concurrent readers.
+type MemoryStore struct {
+	mu sync.RWMutex
+
+	users    map[string]*service.User
+	activity map[string][]activityRef
+	posts    map[string]*service.Post
+	comments map[string]*service.Comment
+
+	// postComments holds refs to the comments of each post
+	postComments map[string][]activityRef
+
+	// audit holds the audit records per user, oldest first
+	audit         map[string][]*service.AuditRecord
+	auditSequence uint64
+
+	// sequences holds the last ID handed out per kind of record
+	sequences map[string]uint64
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		users:     make(map[string]*service.User),
+		activity:  make(map[string][]activityRef),
+		posts:     make(map[string]*service.Post),
+		comments:  make(map[string]*service.Comment),
+		audit:     make(map[string][]*service.AuditRecord),
+		sequences: make(map[string]uint64),
+
+		postComments: make(map[string][]activityRef),
+	}
+}
+
+// GetUser returns the user with the given ID, with recentActivity resolved
+func (m *MemoryStore) GetUser(ctx context.Context, id string) (*service.User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	user, found := m.users[id]
+	if !found || !visible(ctx, user.DeletedAt) {
+		return nil, ErrNotFound
+	}
+	return m.withActivity(ctx, user), nil
+}
+
+// ListUsers returns all users in the store, with recentActivity resolved
+func (m *MemoryStore) ListUsers(ctx context.Context) ([]*service.User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	users := make([]*service.User, 0, len(m.users))
+	for _, user := range m.users {
+		if visible(ctx, user.DeletedAt) {
+			users = append(users, m.withActivity(ctx, user))
+		}
+	}
+	return users, nil
+}
+
+// SaveUser creates or replaces a user. The recentActivity field is ignored.
+func (m *MemoryStore) SaveUser(ctx context.Context, user *service.User) error {
+	return m.SaveUsers(ctx, []*service.User{user})
+}
+
+// SaveUsers creates or replaces several users at once, so readers never see
+// only some of them updated. The recentActivity field is ignored.
+func (m *MemoryStore) SaveUsers(ctx context.Context, users []*service.User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Check every version before anything is saved
+	now := time.Now()
+	stamped := make([]*service.User, len(users))
+	for i, user := range users {
+		stored := proto.Clone(user).(*service.User)
+		stored.RecentActivity = nil
+		if err := stampUser(stored, m.users[user.Id], now); err != nil {
+			return err
+		}
+		stamped[i] = stored
+	}
+
+	for i, user := range users {
+		m.users[user.Id] = stamped[i]
+		m.advanceSequence(idKindUser, user.Id)
+		user.Version, user.UpdatedAt = stamped[i].Version, stamped[i].UpdatedAt
+	}
+	return nil
+}
+
+// ListUserActivity returns the posts and comments of a user, most recently
+// created first
+func (m *MemoryStore) ListUserActivity(ctx context.Context, userID string) ([]*service.ActivityItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if user, found := m.users[userID]; !found || !visible(ctx, user.DeletedAt) {
+		return nil, ErrNotFound
+	}
+	return m.resolveActivity(ctx, userID), nil
+}
+
+// DeleteUser marks a user as deleted at the given time
+func (m *MemoryStore) DeleteUser(ctx context.Context, id string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user, found := m.users[id]
+	if !found || user.DeletedAt != nil {
+		return ErrNotFound
+	}
+	deleted := proto.Clone(user).(*service.User)
+	deleted.DeletedAt = wrapperspb.String(formatTimestamp(at))
+	if err := stampUser(deleted, user, at); err != nil {
+		return err
+	}
+	m.users[id] = deleted
+	return nil
+}
+
+// RestoreUser clears the tombstone of a deleted user
+func (m *MemoryStore) RestoreUser(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user, found := m.users[id]
+	if !found {
+		return ErrNotFound
+	}
+	if user.DeletedAt == nil {
+		return nil
+	}
+	restored := proto.Clone(user).(*service.User)
+	restored.DeletedAt = nil
+	if err := stampUser(restored, user, time.Now()); err != nil {
+		return err
+	}
+	m.users[id] = restored
+	return nil
+}
+
+// withActivity returns a copy of the user with recentActivity resolved from the index.
+// The caller must hold the read lock.
+func (m *MemoryStore) withActivity(ctx context.Context, user *service.User) *service.User {
+	cloned := proto.Clone(user).(*service.User)
+	cloned.RecentActivity = m.resolveActivity(ctx, user.Id)
+	return cloned
+}
+
+// resolveActivity converts the activity index of a user into activity items,
+// most recently created first, skipping deleted posts and comments unless ctx
+// includes them.
+// The caller must hold the read lock.
+func (m *MemoryStore) resolveActivity(ctx context.Context, userID string) []*service.ActivityItem {
+	refs := m.activity[userID]
+	items := make([]*service.ActivityItem, 0, len(refs))
+	for _, ref := range refs {
+		switch ref.Kind {
+		case activityKindPost:
+			if post, found := m.posts[ref.ID]; found && visiblePost(ctx, post) {
+				items = append(items, &service.ActivityItem{Value: &service.ActivityItem_Post{Post: m.withComments(ctx, post)}})
+			}
+		case activityKindComment:
+			if comment, found := m.comments[ref.ID]; found && visibleComment(ctx, comment, m.posts[comment.PostId]) {
+				items = append(items, &service.ActivityItem{Value: &service.ActivityItem_Comment{Comment: proto.Clone(comment).(*service.Comment)}})
+			}
+		}
+	}
+	sortActivity(items)
+	return items
+}
+
+// withComments returns a copy of the post with its comments resolved from the
+// index, skipping deleted comments unless ctx includes them.
+// The caller must hold the read lock.
+func (m *MemoryStore) withComments(ctx context.Context, post *service.Post) *service.Post {
+	cloned := proto.Clone(post).(*service.Post)
+	cloned.Comments = make([]*service.Comment, 0, len(m.postComments[post.Id]))
+	for _, ref := range m.postComments[post.Id] {
+		if comment, found := m.comments[ref.ID]; found && visible(ctx, comment.DeletedAt) {
+			cloned.Comments = append(cloned.Comments, proto.Clone(comment).(*service.Comment))
+		}
+	}
+	slices.SortFunc(cloned.Comments, compareComments)
+	return cloned
+}
+
+// GetPost returns the post with the given ID, with comments resolved
+func (m *MemoryStore) GetPost(ctx context.Context, id string) (*service.Post, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	post, found := m.posts[id]
+	if !found || !visiblePost(ctx, post) {
+		return nil, ErrNotFound
+	}
+	return m.withComments(ctx, post), nil
+}
+
+// ListPosts returns all posts in the store, with comments resolved
+func (m *MemoryStore) ListPosts(ctx context.Context) ([]*service.Post, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	posts := make([]*service.Post, 0, len(m.posts))
+	for _, post := range m.posts {
+		if visiblePost(ctx, post) {
+			posts = append(posts, m.withComments(ctx, post))
+		}
+	}
+	return posts, nil
+}
+
+// SavePost creates or replaces a post and sets its createdAt and updatedAt.
+// New posts are added to the activity index of their author. The comments
+// field is ignored.
+func (m *MemoryStore) SavePost(ctx context.Context, post *service.Post) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var previousAuthorID, storedCreatedAt string
+	if existing, found := m.posts[post.Id]; found {
+		previousAuthorID, storedCreatedAt = existing.AuthorId, existing.CreatedAt
+	}
+
+	now := time.Now()
+	post.CreatedAt = creationTime(post.CreatedAt, storedCreatedAt, now)
+	post.UpdatedAt = formatTimestamp(now)
+	stored := proto.Clone(post).(*service.Post)
+	stored.Comments = nil
+	m.posts[post.Id] = stored
+	m.advanceSequence(idKindPost, post.Id)
+	moveRef(m.activity, activityRef{Kind: activityKindPost, ID: post.Id}, previousAuthorID, post.AuthorId)
+	return nil
+}
+
+// DeletePost marks a post as deleted at the given time
+func (m *MemoryStore) DeletePost(ctx context.Context, id string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	post, found := m.posts[id]
+	if !found || post.DeletedAt != nil {
+		return ErrNotFound
+	}
+	deleted := proto.Clone(post).(*service.Post)
+	deleted.DeletedAt = wrapperspb.String(formatTimestamp(at))
+	deleted.UpdatedAt = formatTimestamp(at)
+	m.posts[id] = deleted
+	return nil
+}
+
+// RestorePost clears the tombstone of a deleted post
+func (m *MemoryStore) RestorePost(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	post, found := m.posts[id]
+	if !found {
+		return ErrNotFound
+	}
+	if post.DeletedAt == nil {
+		return nil
+	}
+	restored := proto.Clone(post).(*service.Post)
+	restored.DeletedAt = nil
+	restored.UpdatedAt = formatTimestamp(time.Now())
+	m.posts[id] = restored
+	return nil
+}
+
+// GetComment returns the comment with the given ID
+func (m *MemoryStore) GetComment(ctx context.Context, id string) (*service.Comment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	comment, found := m.comments[id]
+	if !found || !visibleComment(ctx, comment, m.posts[comment.PostId]) {
+		return nil, ErrNotFound
+	}
+	return proto.Clone(comment).(*service.Comment), nil
+}
+
+// ListComments returns all comments in the store
+func (m *MemoryStore) ListComments(ctx context.Context) ([]*service.Comment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	comments := make([]*service.Comment, 0, len(m.comments))
+	for _, comment := range m.comments {
+		if visibleComment(ctx, comment, m.posts[comment.PostId]) {
+			comments = append(comments, proto.Clone(comment).(*service.Comment))
+		}
+	}
+	return comments, nil
+}
+
+// SaveComment creates or replaces a comment and sets its createdAt and updatedAt.
+// New comments are added to the activity index of their author and to the
+// comments of their post.
+func (m *MemoryStore) SaveComment(ctx context.Context, comment *service.Comment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var previousAuthorID, previousPostID, storedCreatedAt string
+	if existing, found := m.comments[comment.Id]; found {
+		previousAuthorID, previousPostID, storedCreatedAt = existing.AuthorId, existing.PostId, existing.CreatedAt
+	}
+
+	now := time.Now()
+	comment.CreatedAt = creationTime(comment.CreatedAt, storedCreatedAt, now)
+	comment.UpdatedAt = formatTimestamp(now)
+	m.comments[comment.Id] = proto.Clone(comment).(*service.Comment)
+	m.advanceSequence(idKindComment, comment.Id)
+	ref := activityRef{Kind: activityKindComment, ID: comment.Id}
+	moveRef(m.activity, ref, previousAuthorID, comment.AuthorId)
+	moveRef(m.postComments, ref, previousPostID, comment.PostId)
+	return nil
+}
+
+// DeleteComment marks a comment as deleted at the given time
+func (m *MemoryStore) DeleteComment(ctx context.Context, id string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	comment, found := m.comments[id]
+	if !found || comment.DeletedAt != nil {
+		return ErrNotFound
+	}
+	deleted := proto.Clone(comment).(*service.Comment)
+	deleted.DeletedAt = wrapperspb.String(formatTimestamp(at))
+	deleted.UpdatedAt = formatTimestamp(at)
+	m.comments[id] = deleted
+	return nil
+}
+
+// RestoreComment clears the tombstone of a deleted comment
+func (m *MemoryStore) RestoreComment(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	comment, found := m.comments[id]
+	if !found {
+		return ErrNotFound
+	}
+	if comment.DeletedAt == nil {
+		return nil
+	}
+	restored := proto.Clone(comment).(*service.Comment)
+	restored.DeletedAt = nil
+	restored.UpdatedAt = formatTimestamp(time.Now())
+	m.comments[id] = restored
+	return nil
+}
+
+// AppendAuditRecords assigns IDs to the records and appends them to the log
+func (m *MemoryStore) AppendAuditRecords(ctx context.Context, records []*service.AuditRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, record := range records {
+		m.auditSequence++
+		record.Id = strconv.FormatUint(m.auditSequence, 10)
+		m.audit[record.UserId] = append(m.audit[record.UserId], proto.Clone(record).(*service.AuditRecord))
+	}
+	return nil
+}
+
+// ListAuditRecords returns the audit records of a user, most recent first
+func (m *MemoryStore) ListAuditRecords(ctx context.Context, userID string) ([]*service.AuditRecord, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stored := m.audit[userID]
+	records := make([]*service.AuditRecord, 0, len(stored))
+	for i := len(stored) - 1; i >= 0; i-- {
+		records = append(records, proto.Clone(stored[i]).(*service.AuditRecord))
+	}
+	return records, nil
+}
+
+// NextID returns the next number of the per-kind sequence. The sequence is
+// advanced by every saved record, so IDs are never handed out twice, even after
+// the record they were used for has been deleted.
+func (m *MemoryStore) NextID(ctx context.Context, kind string) (string, error) {
+	switch kind {
+	case idKindUser, idKindPost, idKindComment:
+	default:
+		return "", fmt.Errorf("unknown ID kind %q", kind)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sequences[kind]++
+	return strconv.FormatUint(m.sequences[kind], 10), nil
+}
+
+// advanceSequence moves the sequence of kind past a saved numeric ID.
+// The caller must hold the write lock.
+func (m *MemoryStore) advanceSequence(kind, id string) {
+	if seq, err := strconv.ParseUint(id, 10, 64); err == nil && seq > m.sequences[kind] {
+		m.sequences[kind] = seq
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMemoryStoreCopyOnRead(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	seedTestStores(t, &storeSet{users: store, posts: store, comments: store})
+
+	// Modifying a returned user must not change the stored user
+	user, err := store.GetUser(ctx, "1")
+	require.NoError(t, err)
+	user.Name = "Changed"
+	user.Profile.DisplayName = &wrapperspb.StringValue{Value: "Changed"}
+
+	stored, err := store.GetUser(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Johnson", stored.Name)
+	assert.Equal(t, "Alice J.", stored.Profile.GetDisplayName().GetValue())
+
+	// Modifying a saved user after the save must not change the stored user
+	stored.Name = "Saved"
+	require.NoError(t, store.SaveUser(ctx, stored))
+	stored.Name = "Changed after save"
+
+	reloaded, err := store.GetUser(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Saved", reloaded.Name)
+
+	// Modifying returned activity must not change the stored activity
+	activity, err := store.ListUserActivity(ctx, "1")
+	require.NoError(t, err)
+	activity[1].GetPost().Title = "Changed"
+
+	activity, err = store.ListUserActivity(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Advanced Federation Patterns", activity[1].GetPost().Title)
+}
+
+func TestMemoryStoreNotFound(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	_, err := store.GetUser(ctx, "1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.ListUserActivity(ctx, "1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.GetPost(ctx, "1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.GetComment(ctx, "1")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+// TestConcurrentMutationsAndLookups hammers the service with parallel mutations
+// and reads. It is meant to be run with the race detector enabled.
+func TestConcurrentMutationsAndLookups(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+
+	ctx := context.Background()
+	const workers = 8
+	const iterations = 25
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(4)
+
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				_, err := svc.usersClient.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{
+					Input: &service.UserInput{
+						Id:      "1",
+						Name:    &wrapperspb.StringValue{Value: fmt.Sprintf("Alice %d-%d", w, i)},
+						Profile: &service.ProfileInput{DisplayName: &wrapperspb.StringValue{Value: fmt.Sprintf("A %d", i)}},
+					},
+				})
+				assert.NoError(t, err)
+			}
+		}(w)
+
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				_, err := svc.usersClient.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{
+					Input: []*service.UserInput{
+						{Id: "1", Bio: &wrapperspb.StringValue{Value: fmt.Sprintf("Bio %d-%d", w, i)}},
+						{Id: "2", Age: &wrapperspb.Int32Value{Value: int32(i)}},
+					},
+				})
+				assert.NoError(t, err)
+			}
+		}(w)
+
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				_, err := svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{
+					Input: &service.PostInput{Title: fmt.Sprintf("Post %d-%d", w, i), AuthorId: "1"},
+				})
+				assert.NoError(t, err)
+			}
+		}(w)
+
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				lookup, err := svc.usersClient.LookupUserById(ctx, &service.LookupUserByIdRequest{
+					Keys: []*service.LookupUserByIdRequestKey{{Id: "1"}, {Id: "2"}},
+				})
+				if assert.NoError(t, err) {
+					assert.Len(t, lookup.Result, 2)
+				}
+
+				users, err := svc.usersClient.QueryUsers(ctx, &service.QueryUsersRequest{})
+				if assert.NoError(t, err) {
+					assert.Len(t, users.Users, 4)
+				}
+
+				_, err = svc.usersClient.QueryUserActivity(ctx, &service.QueryUserActivityRequest{UserId: "1"})
+				assert.NoError(t, err)
+
+				_, err = svc.usersClient.QuerySearch(ctx, &service.QuerySearchRequest{Query: "alice"})
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Every update must have produced a consistent, readable user
+	resp, err := svc.usersClient.QueryUser(ctx, &service.QueryUserRequest{Id: "1"})
+	require.NoError(t, err)
+	assert.Contains(t, resp.User.Name, "Alice ")
+}
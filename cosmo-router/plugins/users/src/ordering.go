@@ -0,0 +1,126 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// userOrdering sorts users by a list of UserOrder criteria.
+// Users that compare equal on all criteria are ordered by ID, so the order is
+// total and the same users always come back in the same order.
+type userOrdering []*service.UserOrder
+
+// newUserOrdering validates the orderBy argument of a user list.
+// An empty orderBy orders users by ID. Invalid orders are reported as a *fieldError.
+func newUserOrdering(orderBy *service.ListOfUserOrder) (userOrdering, error) {
+	orders := orderBy.GetList().GetItems()
+	for i, order := range orders {
+		if order.Field == service.UserOrderField_USER_ORDER_FIELD_UNSPECIFIED {
+			return nil, &fieldError{Field: fmt.Sprintf("orderBy[%d].field", i), Message: "is required"}
+		}
+		if _, ok := service.UserOrderField_name[int32(order.Field)]; !ok {
+			return nil, &fieldError{Field: fmt.Sprintf("orderBy[%d].field", i), Message: fmt.Sprintf("unknown field %d", order.Field)}
+		}
+		if _, ok := service.OrderDirection_name[int32(order.Direction)]; !ok {
+			return nil, &fieldError{Field: fmt.Sprintf("orderBy[%d].direction", i), Message: fmt.Sprintf("unknown direction %d", order.Direction)}
+		}
+	}
+	return userOrdering(orders), nil
+}
+
+// sort sorts users in place
+func (o userOrdering) sort(users []*service.User) {
+	slices.SortFunc(users, o.compare)
+}
+
+// compare reports how a sorts relative to b
+func (o userOrdering) compare(a, b *service.User) int {
+	for _, order := range o {
+		c := compareUserField(order.Field, a, b)
+		if order.Direction == service.OrderDirection_ORDER_DIRECTION_DESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return compareIDs(a.Id, b.Id)
+}
+
+// compareUserField compares a single field of two users in ascending order.
+// Users without an age sort after all users with an age, so they come last in
+// ascending and first in descending order.
+func compareUserField(field service.UserOrderField, a, b *service.User) int {
+	switch field {
+	case service.UserOrderField_USER_ORDER_FIELD_ID:
+		return compareIDs(a.Id, b.Id)
+	case service.UserOrderField_USER_ORDER_FIELD_NAME:
+		return strings.Compare(a.Name, b.Name)
+	case service.UserOrderField_USER_ORDER_FIELD_EMAIL:
+		return strings.Compare(a.Email, b.Email)
+	case service.UserOrderField_USER_ORDER_FIELD_AGE:
+		switch {
+		case a.Age == nil && b.Age == nil:
+			return 0
+		case a.Age == nil:
+			return 1
+		case b.Age == nil:
+			return -1
+		}
+		return cmp.Compare(a.Age.Value, b.Age.Value)
+	case service.UserOrderField_USER_ORDER_FIELD_ROLE:
+		// Roles sort in the order they are declared in the schema
+		return cmp.Compare(a.Role, b.Role)
+	}
+	return 0
+}
+
+// cursorCodec returns the codec for cursors of a user connection sorted by o.
+// A cursor stores the user reduced to its ID and the fields it is sorted by,
+// and is only accepted by connections with the same ordering.
+func (o userOrdering) cursorCodec() cursorCodec[*service.User] {
+	kind := []string{"user"}
+	for _, order := range o {
+		field := strings.TrimPrefix(order.Field.String(), "USER_ORDER_FIELD_")
+		direction := "ASC"
+		if order.Direction == service.OrderDirection_ORDER_DIRECTION_DESC {
+			direction = "DESC"
+		}
+		kind = append(kind, field+"."+direction)
+	}
+
+	return cursorCodec[*service.User]{
+		kind: strings.Join(kind, "/"),
+		encode: func(user *service.User) string {
+			key := &service.User{Id: user.Id}
+			for _, order := range o {
+				switch order.Field {
+				case service.UserOrderField_USER_ORDER_FIELD_NAME:
+					key.Name = user.Name
+				case service.UserOrderField_USER_ORDER_FIELD_EMAIL:
+					key.Email = user.Email
+				case service.UserOrderField_USER_ORDER_FIELD_AGE:
+					if user.Age != nil {
+						key.Age = wrapperspb.Int32(user.Age.Value)
+					}
+				case service.UserOrderField_USER_ORDER_FIELD_ROLE:
+					key.Role = user.Role
+				}
+			}
+			return marshalCursorKey(key)
+		},
+		decode: func(key string) (*service.User, error) {
+			user := &service.User{}
+			if err := proto.Unmarshal([]byte(key), user); err != nil {
+				return nil, err
+			}
+			return user, nil
+		},
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Page size limits of connection fields
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// connectionArgs holds the validated Relay pagination arguments of a connection field.
+// Exactly one of first and last is non-negative; after and before are the raw
+// cursors, nil when not set.
+type connectionArgs struct {
+	first  int
+	last   int
+	after  *wrapperspb.StringValue
+	before *wrapperspb.StringValue
+}
+
+// parseConnectionArgs validates first/after/last/before of a connection field.
+// When neither first nor last is given, the first defaultPageSize items are returned.
+// Invalid arguments are reported as a *fieldError.
+func parseConnectionArgs(first *wrapperspb.Int32Value, after *wrapperspb.StringValue, last *wrapperspb.Int32Value, before *wrapperspb.StringValue) (*connectionArgs, error) {
+	if first != nil && last != nil {
+		return nil, &fieldError{Field: "last", Message: "can't be combined with first"}
+	}
+
+	args := &connectionArgs{first: -1, last: -1, after: after, before: before}
+	switch {
+	case first != nil:
+		if first.Value < 0 || first.Value > maxPageSize {
+			return nil, &fieldError{Field: "first", Message: fmt.Sprintf("must be between 0 and %d, got %d", maxPageSize, first.Value)}
+		}
+		args.first = int(first.Value)
+	case last != nil:
+		if last.Value < 0 || last.Value > maxPageSize {
+			return nil, &fieldError{Field: "last", Message: fmt.Sprintf("must be between 0 and %d, got %d", maxPageSize, last.Value)}
+		}
+		args.last = int(last.Value)
+	default:
+		args.first = defaultPageSize
+	}
+	return args, nil
+}
+
+// cursorCodec converts between items and the sort keys stored in their cursors.
+// A cursor decodes into a (possibly partial) item that carries just enough
+// fields to be compared with the items of the connection.
+type cursorCodec[T any] struct {
+	// kind identifies the connection and ordering the cursor was issued for
+	kind   string
+	encode func(item T) string
+	decode func(key string) (T, error)
+}
+
+// connectionPage is the window of a sorted result set selected by connectionArgs
+type connectionPage[T any] struct {
+	Items    []T
+	Cursors  []string
+	PageInfo *service.PageInfo
+}
+
+// paginate selects the page described by args from items, which must be sorted
+// by compare. Cursors are located by comparing their decoded sort key with the
+// items, so they stay valid when the item they point at is gone. Invalid
+// cursors are reported as a *fieldError.
+func paginate[T any](items []T, args *connectionArgs, compare func(a, b T) int, codec cursorCodec[T]) (*connectionPage[T], error) {
+	start, end := 0, len(items)
+	if args.after != nil {
+		after, err := decodeCursorItem(codec, args.after.Value)
+		if err != nil {
+			return nil, &fieldError{Field: "after", Message: err.Error()}
+		}
+		start = sort.Search(len(items), func(i int) bool { return compare(items[i], after) > 0 })
+	}
+	if args.before != nil {
+		before, err := decodeCursorItem(codec, args.before.Value)
+		if err != nil {
+			return nil, &fieldError{Field: "before", Message: err.Error()}
+		}
+		end = sort.Search(len(items), func(i int) bool { return compare(items[i], before) >= 0 })
+	}
+	if end < start {
+		end = start
+	}
+
+	if args.first >= 0 && end-start > args.first {
+		end = start + args.first
+	}
+	if args.last >= 0 && end-start > args.last {
+		start = end - args.last
+	}
+
+	page := &connectionPage[T]{
+		Items:   items[start:end],
+		Cursors: make([]string, 0, end-start),
+		PageInfo: &service.PageInfo{
+			HasNextPage:     end < len(items),
+			HasPreviousPage: start > 0,
+		},
+	}
+	for _, item := range page.Items {
+		page.Cursors = append(page.Cursors, encodeCursor(codec.kind, codec.encode(item)))
+	}
+	if len(page.Cursors) > 0 {
+		page.PageInfo.StartCursor = wrapperspb.String(page.Cursors[0])
+		page.PageInfo.EndCursor = wrapperspb.String(page.Cursors[len(page.Cursors)-1])
+	}
+	return page, nil
+}
+
+// decodeCursorItem decodes a cursor issued by paginate with the same codec
+func decodeCursorItem[T any](codec cursorCodec[T], cursor string) (T, error) {
+	key, err := decodeCursor(codec.kind, cursor)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	item, err := codec.decode(key)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("malformed cursor %q", cursor)
+	}
+	return item, nil
+}
+
+// encodeCursor builds an opaque cursor from the kind of connection and the sort key of an item
+func encodeCursor(kind, key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(kind + ":" + key))
+}
+
+// marshalCursorKey encodes the sort key of an item for a cursorCodec.
+// Marshalling only fails on strings that are not valid UTF-8, and keys are
+// copied from stored records, whose strings were decoded from gRPC requests or
+// fixture files or generated by the service, so the error is ignored.
+func marshalCursorKey(key proto.Message) string {
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(key)
+	return string(data)
+}
+
+// decodeCursor returns the sort key of a cursor created by encodeCursor for the same kind
+func decodeCursor(kind, cursor string) (string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", fmt.Errorf("malformed cursor %q", cursor)
+	}
+	key, found := strings.CutPrefix(string(data), kind+":")
+	if !found {
+		return "", fmt.Errorf("cursor %q does not belong to this connection", cursor)
+	}
+	return key, nil
+}
+
+// compareIDs orders IDs by length and then lexicographically.
+// Numeric IDs therefore sort numerically ("2" before "10"), and fixed-length
+// IDs keep their lexicographic order.
+func compareIDs(a, b string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	return strings.Compare(a, b)
+}
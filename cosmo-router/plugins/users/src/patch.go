@@ -0,0 +1,298 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// fieldChange is a single field changed by a user patch. Old and New hold the
+// values as they appear in GraphQL: strings, ints, enum value names, string
+// lists, or nil for null.
+type fieldChange struct {
+	// Field is the path of the field in the schema, e.g. "profile.displayName"
+	Field string
+	Old   any
+	New   any
+}
+
+// userDiff lists the fields changed by a patch in schema order
+type userDiff []fieldChange
+
+// fields returns the paths of the changed fields
+func (d userDiff) fields() []string {
+	fields := make([]string, 0, len(d))
+	for _, change := range d {
+		fields = append(fields, change.Field)
+	}
+	return fields
+}
+
+// fieldError reports an invalid field of an input
+type fieldError struct {
+	// Field is the path of the field within the input, e.g. "profile.timezone"
+	Field   string
+	Message string
+}
+
+// Error implements the error interface
+func (e *fieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// applyUserInput applies a partial update to user in place and returns the
+// fields it changed.
+//
+// Fields that are missing from input keep their value, fields that are given
+// replace it, even with an empty string or list, and fields listed in unset are
+// set to null. Inputs that give a field a value and unset it at the same time
+// are rejected with a *fieldError before user is modified. The ID and
+// recentActivity are never changed.
+func applyUserInput(user *service.User, input *service.UserInput) (userDiff, error) {
+	if err := validateUnset(input); err != nil {
+		return nil, err
+	}
+	before := proto.Clone(user).(*service.User)
+
+	if input.Name != nil {
+		user.Name = input.Name.Value
+	}
+	if input.Email != nil {
+		user.Email = input.Email.Value
+	}
+	if input.Role != service.UserRole_USER_ROLE_UNSPECIFIED {
+		user.Role = input.Role
+	}
+	if input.Permissions != nil {
+		user.Permissions = slices.Clone(input.Permissions.GetList().GetItems())
+	}
+	if input.Tags != nil {
+		user.Tags = &service.ListOfString{List: &service.ListOfString_List{Items: slices.Clone(input.Tags.GetList().GetItems())}}
+	}
+	if input.SkillCategories != nil {
+		user.SkillCategories = proto.Clone(input.SkillCategories).(*service.ListOfListOfString)
+	}
+	if input.Bio != nil {
+		user.Bio = proto.Clone(input.Bio).(*wrapperspb.StringValue)
+	}
+	if input.Age != nil {
+		user.Age = proto.Clone(input.Age).(*wrapperspb.Int32Value)
+	}
+
+	if profile := input.Profile; profile != nil {
+		if user.Profile == nil {
+			user.Profile = &service.Profile{}
+		}
+		if profile.DisplayName != nil {
+			user.Profile.DisplayName = proto.Clone(profile.DisplayName).(*wrapperspb.StringValue)
+		}
+		if profile.Timezone != nil {
+			user.Profile.Timezone = proto.Clone(profile.Timezone).(*wrapperspb.StringValue)
+		}
+		if profile.Theme != service.Theme_THEME_UNSPECIFIED {
+			user.Profile.Theme = profile.Theme
+		}
+		for _, field := range profile.Unset.GetList().GetItems() {
+			switch field {
+			case service.NullableProfileField_NULLABLE_PROFILE_FIELD_DISPLAY_NAME:
+				user.Profile.DisplayName = nil
+			case service.NullableProfileField_NULLABLE_PROFILE_FIELD_TIMEZONE:
+				user.Profile.Timezone = nil
+			case service.NullableProfileField_NULLABLE_PROFILE_FIELD_THEME:
+				user.Profile.Theme = service.Theme_THEME_UNSPECIFIED
+			}
+		}
+	}
+
+	for _, field := range input.Unset.GetList().GetItems() {
+		switch field {
+		case service.NullableUserField_NULLABLE_USER_FIELD_TAGS:
+			user.Tags = nil
+		case service.NullableUserField_NULLABLE_USER_FIELD_BIO:
+			user.Bio = nil
+		case service.NullableUserField_NULLABLE_USER_FIELD_AGE:
+			user.Age = nil
+		case service.NullableUserField_NULLABLE_USER_FIELD_PROFILE:
+			user.Profile = nil
+		}
+	}
+
+	return diffUsers(before, user), nil
+}
+
+// validateUnset rejects an input that gives a field a value and unsets it at the same time
+func validateUnset(input *service.UserInput) *fieldError {
+	for _, field := range input.Unset.GetList().GetItems() {
+		var set bool
+		switch field {
+		case service.NullableUserField_NULLABLE_USER_FIELD_TAGS:
+			set = input.Tags != nil
+		case service.NullableUserField_NULLABLE_USER_FIELD_BIO:
+			set = input.Bio != nil
+		case service.NullableUserField_NULLABLE_USER_FIELD_AGE:
+			set = input.Age != nil
+		case service.NullableUserField_NULLABLE_USER_FIELD_PROFILE:
+			set = input.Profile != nil
+		default:
+			return &fieldError{Field: "unset", Message: fmt.Sprintf("unknown field %d", field)}
+		}
+		if set {
+			return &fieldError{Field: nullableFieldName(field.String()), Message: "can't be set and unset at the same time"}
+		}
+	}
+
+	profile := input.Profile
+	for _, field := range profile.GetUnset().GetList().GetItems() {
+		var set bool
+		switch field {
+		case service.NullableProfileField_NULLABLE_PROFILE_FIELD_DISPLAY_NAME:
+			set = profile.DisplayName != nil
+		case service.NullableProfileField_NULLABLE_PROFILE_FIELD_TIMEZONE:
+			set = profile.Timezone != nil
+		case service.NullableProfileField_NULLABLE_PROFILE_FIELD_THEME:
+			set = profile.Theme != service.Theme_THEME_UNSPECIFIED
+		default:
+			return &fieldError{Field: "profile.unset", Message: fmt.Sprintf("unknown field %d", field)}
+		}
+		if set {
+			return &fieldError{Field: "profile." + nullableFieldName(field.String()), Message: "can't be set and unset at the same time"}
+		}
+	}
+	return nil
+}
+
+// diffUsers returns the fields that differ between two versions of a user.
+// A profile that is added or removed is reported as a single change of the
+// profile field, changes within a profile per profile field.
+func diffUsers(before, after *service.User) userDiff {
+	var diff userDiff
+	add := func(field string, old, new any) {
+		if !valuesEqual(old, new) {
+			diff = append(diff, fieldChange{Field: field, Old: old, New: new})
+		}
+	}
+
+	add("name", before.Name, after.Name)
+	add("email", before.Email, after.Email)
+	add("role", enumValueName(before.Role.String(), "USER_ROLE_"), enumValueName(after.Role.String(), "USER_ROLE_"))
+	add("permissions", stringList(before.Permissions), stringList(after.Permissions))
+	add("tags", listOfStringValue(before.Tags), listOfStringValue(after.Tags))
+	add("skillCategories", skillCategoriesValue(before.SkillCategories), skillCategoriesValue(after.SkillCategories))
+	add("bio", stringValue(before.Bio), stringValue(after.Bio))
+	add("age", int32Value(before.Age), int32Value(after.Age))
+
+	switch {
+	case before.Profile == nil || after.Profile == nil:
+		add("profile", profileValue(before.Profile), profileValue(after.Profile))
+	default:
+		add("profile.displayName", stringValue(before.Profile.DisplayName), stringValue(after.Profile.DisplayName))
+		add("profile.timezone", stringValue(before.Profile.Timezone), stringValue(after.Profile.Timezone))
+		add("profile.theme", themeValue(before.Profile.Theme), themeValue(after.Profile.Theme))
+	}
+	add("deletedAt", stringValue(before.DeletedAt), stringValue(after.DeletedAt))
+	return diff
+}
+
+// valuesEqual compares two values produced by the diff helpers
+func valuesEqual(a, b any) bool {
+	switch a := a.(type) {
+	case []string:
+		b, ok := b.([]string)
+		return ok && slices.Equal(a, b)
+	case [][]string:
+		b, ok := b.([][]string)
+		return ok && slices.EqualFunc(a, b, slices.Equal)
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			if bv, found := b[k]; !found || bv != v {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
+
+// stringList returns a non-nil copy of a list, so empty lists compare equal
+func stringList(items []string) []string {
+	return append([]string{}, items...)
+}
+
+// listOfStringValue returns the items of a nullable list, or nil for null
+func listOfStringValue(list *service.ListOfString) any {
+	if list == nil {
+		return nil
+	}
+	return stringList(list.GetList().GetItems())
+}
+
+// skillCategoriesValue returns the nested lists of skill categories.
+// A missing list is reported as empty, because skillCategories is non-null.
+func skillCategoriesValue(list *service.ListOfListOfString) [][]string {
+	categories := [][]string{}
+	for _, category := range list.GetList().GetItems() {
+		categories = append(categories, stringList(category.GetList().GetItems()))
+	}
+	return categories
+}
+
+// stringValue returns the value of a nullable string, or nil for null
+func stringValue(v *wrapperspb.StringValue) any {
+	if v == nil {
+		return nil
+	}
+	return v.Value
+}
+
+// int32Value returns the value of a nullable int, or nil for null
+func int32Value(v *wrapperspb.Int32Value) any {
+	if v == nil {
+		return nil
+	}
+	return v.Value
+}
+
+// themeValue returns the GraphQL name of a theme, or nil for null
+func themeValue(theme service.Theme) any {
+	if theme == service.Theme_THEME_UNSPECIFIED {
+		return nil
+	}
+	return enumValueName(theme.String(), "THEME_")
+}
+
+// profileValue returns the fields of a profile, or nil for null
+func profileValue(profile *service.Profile) any {
+	if profile == nil {
+		return nil
+	}
+	return map[string]any{
+		"displayName": stringValue(profile.DisplayName),
+		"timezone":    stringValue(profile.Timezone),
+		"theme":       themeValue(profile.Theme),
+	}
+}
+
+// enumValueName strips the proto prefix from an enum value name, e.g. USER_ROLE_ADMIN becomes ADMIN
+func enumValueName(value, prefix string) string {
+	return strings.TrimPrefix(value, prefix)
+}
+
+// nullableFieldName turns an enum value name like NULLABLE_USER_FIELD_BIO or
+// NULLABLE_PROFILE_FIELD_DISPLAY_NAME into the GraphQL field name
+func nullableFieldName(value string) string {
+	value = strings.TrimPrefix(value, "NULLABLE_USER_FIELD_")
+	value = strings.TrimPrefix(value, "NULLABLE_PROFILE_FIELD_")
+	parts := strings.Split(strings.ToLower(value), "_")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// patchTestUser returns a user with every field set
+func patchTestUser() *service.User {
+	return &service.User{
+		Id:          "1",
+		Name:        "Alice Johnson",
+		Email:       "alice@example.com",
+		Role:        service.UserRole_USER_ROLE_ADMIN,
+		Permissions: []string{"read", "write"},
+		Tags:        &service.ListOfString{List: &service.ListOfString_List{Items: []string{"admin", "user"}}},
+		SkillCategories: &service.ListOfListOfString{List: &service.ListOfListOfString_List{Items: []*service.ListOfString{
+			{List: &service.ListOfString_List{Items: []string{"Go", "Rust"}}},
+		}}},
+		Profile: &service.Profile{
+			DisplayName: wrapperspb.String("Alice J."),
+			Timezone:    wrapperspb.String("America/New_York"),
+			Theme:       service.Theme_THEME_DARK,
+		},
+		Bio: wrapperspb.String("Full-stack developer"),
+		Age: wrapperspb.Int32(28),
+	}
+}
+
+func unsetUser(fields ...service.NullableUserField) *service.ListOfNullableUserField {
+	return &service.ListOfNullableUserField{List: &service.ListOfNullableUserField_List{Items: fields}}
+}
+
+func unsetProfile(fields ...service.NullableProfileField) *service.ListOfNullableProfileField {
+	return &service.ListOfNullableProfileField{List: &service.ListOfNullableProfileField_List{Items: fields}}
+}
+
+func TestApplyUserInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     func() *service.User
+		input    *service.UserInput
+		want     func(user *service.User)
+		wantDiff userDiff
+		wantErr  bool
+	}{
+		{
+			name:  "empty input",
+			input: &service.UserInput{Id: "1"},
+		},
+		{
+			name:  "scalar fields",
+			input: &service.UserInput{Id: "1", Name: wrapperspb.String("Alice Smith"), Age: wrapperspb.Int32(29), Role: service.UserRole_USER_ROLE_GUEST},
+			want: func(u *service.User) {
+				u.Name = "Alice Smith"
+				u.Age = wrapperspb.Int32(29)
+				u.Role = service.UserRole_USER_ROLE_GUEST
+			},
+			wantDiff: userDiff{{Field: "name", Old: "Alice Johnson", New: "Alice Smith"}, {Field: "role", Old: "ADMIN", New: "GUEST"}, {Field: "age", Old: int32(28), New: int32(29)}},
+		},
+		{
+			name:  "same values",
+			input: &service.UserInput{Id: "1", Name: wrapperspb.String("Alice Johnson"), Tags: &service.ListOfString{List: &service.ListOfString_List{Items: []string{"admin", "user"}}}},
+		},
+		{
+			name:     "empty string",
+			input:    &service.UserInput{Id: "1", Bio: wrapperspb.String("")},
+			want:     func(u *service.User) { u.Bio = wrapperspb.String("") },
+			wantDiff: userDiff{{Field: "bio", Old: "Full-stack developer", New: ""}},
+		},
+		{
+			name:  "empty lists",
+			input: &service.UserInput{Id: "1", Permissions: &service.ListOfString{}, Tags: &service.ListOfString{}},
+			want: func(u *service.User) {
+				u.Permissions = nil
+				u.Tags = &service.ListOfString{List: &service.ListOfString_List{}}
+			},
+			wantDiff: userDiff{{Field: "permissions", Old: []string{"read", "write"}, New: []string{}}, {Field: "tags", Old: []string{"admin", "user"}, New: []string{}}},
+		},
+		{
+			name: "skill categories",
+			input: &service.UserInput{Id: "1", SkillCategories: &service.ListOfListOfString{List: &service.ListOfListOfString_List{Items: []*service.ListOfString{
+				{List: &service.ListOfString_List{Items: []string{"Go"}}},
+			}}}},
+			want: func(u *service.User) {
+				u.SkillCategories = &service.ListOfListOfString{List: &service.ListOfListOfString_List{Items: []*service.ListOfString{
+					{List: &service.ListOfString_List{Items: []string{"Go"}}},
+				}}}
+			},
+			wantDiff: userDiff{{Field: "skillCategories", Old: [][]string{{"Go", "Rust"}}, New: [][]string{{"Go"}}}},
+		},
+		{
+			name: "unset fields",
+			input: &service.UserInput{Id: "1", Unset: unsetUser(
+				service.NullableUserField_NULLABLE_USER_FIELD_TAGS,
+				service.NullableUserField_NULLABLE_USER_FIELD_BIO,
+				service.NullableUserField_NULLABLE_USER_FIELD_AGE,
+			)},
+			want: func(u *service.User) { u.Tags = nil; u.Bio = nil; u.Age = nil },
+			wantDiff: userDiff{
+				{Field: "tags", Old: []string{"admin", "user"}, New: nil},
+				{Field: "bio", Old: "Full-stack developer", New: nil},
+				{Field: "age", Old: int32(28), New: nil},
+			},
+		},
+		{
+			name:  "unset field that is already null",
+			user:  func() *service.User { u := patchTestUser(); u.Bio = nil; return u },
+			input: &service.UserInput{Id: "1", Unset: unsetUser(service.NullableUserField_NULLABLE_USER_FIELD_BIO)},
+			want:  func(u *service.User) { u.Bio = nil },
+		},
+		{
+			name: "profile fields",
+			input: &service.UserInput{Id: "1", Profile: &service.ProfileInput{
+				Timezone: wrapperspb.String("Europe/Berlin"),
+				Unset:    unsetProfile(service.NullableProfileField_NULLABLE_PROFILE_FIELD_THEME),
+			}},
+			want: func(u *service.User) {
+				u.Profile.Timezone = wrapperspb.String("Europe/Berlin")
+				u.Profile.Theme = service.Theme_THEME_UNSPECIFIED
+			},
+			wantDiff: userDiff{
+				{Field: "profile.timezone", Old: "America/New_York", New: "Europe/Berlin"},
+				{Field: "profile.theme", Old: "DARK", New: nil},
+			},
+		},
+		{
+			name:     "profile of a user without one",
+			user:     func() *service.User { u := patchTestUser(); u.Profile = nil; return u },
+			input:    &service.UserInput{Id: "1", Profile: &service.ProfileInput{Theme: service.Theme_THEME_LIGHT}},
+			want:     func(u *service.User) { u.Profile = &service.Profile{Theme: service.Theme_THEME_LIGHT} },
+			wantDiff: userDiff{{Field: "profile", Old: nil, New: map[string]any{"displayName": nil, "timezone": nil, "theme": "LIGHT"}}},
+		},
+		{
+			name:     "unset profile",
+			input:    &service.UserInput{Id: "1", Unset: unsetUser(service.NullableUserField_NULLABLE_USER_FIELD_PROFILE)},
+			want:     func(u *service.User) { u.Profile = nil },
+			wantDiff: userDiff{{Field: "profile", Old: map[string]any{"displayName": "Alice J.", "timezone": "America/New_York", "theme": "DARK"}, New: nil}},
+		},
+		{
+			name:    "set and unset",
+			input:   &service.UserInput{Id: "1", Age: wrapperspb.Int32(30), Unset: unsetUser(service.NullableUserField_NULLABLE_USER_FIELD_AGE)},
+			wantErr: true,
+		},
+		{
+			name:    "set and unset profile",
+			input:   &service.UserInput{Id: "1", Profile: &service.ProfileInput{}, Unset: unsetUser(service.NullableUserField_NULLABLE_USER_FIELD_PROFILE)},
+			wantErr: true,
+		},
+		{
+			name:    "set and unset profile field",
+			input:   &service.UserInput{Id: "1", Profile: &service.ProfileInput{DisplayName: wrapperspb.String("A."), Unset: unsetProfile(service.NullableProfileField_NULLABLE_PROFILE_FIELD_DISPLAY_NAME)}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown unset field",
+			input:   &service.UserInput{Id: "1", Unset: unsetUser(service.NullableUserField(42))},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := patchTestUser()
+			if tt.user != nil {
+				user = tt.user()
+			}
+			want := proto.Clone(user).(*service.User)
+			if tt.want != nil {
+				tt.want(want)
+			}
+
+			diff, err := applyUserInput(user, tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				want = patchTestUser()
+				if tt.user != nil {
+					want = tt.user()
+				}
+				assert.True(t, proto.Equal(want, user), "user was modified by a rejected input")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(want, user), "got %v, want %v", user, want)
+			assert.Equal(t, tt.wantDiff, diff)
+		})
+	}
+}
+
+func TestApplyUserInputDoesNotAliasInput(t *testing.T) {
+	user := patchTestUser()
+	input := &service.UserInput{
+		Id:          "1",
+		Permissions: &service.ListOfString{List: &service.ListOfString_List{Items: []string{"read"}}},
+		Bio:         wrapperspb.String("New bio"),
+	}
+	_, err := applyUserInput(user, input)
+	require.NoError(t, err)
+
+	input.Permissions.List.Items[0] = "admin"
+	input.Bio.Value = "Changed later"
+	assert.Equal(t, []string{"read"}, user.Permissions)
+	assert.Equal(t, "New bio", user.Bio.GetValue())
+}
+
+func FuzzApplyUserInput(f *testing.F) {
+	seeds := []*service.UserInput{
+		{Id: "1"},
+		{Id: "1", Name: wrapperspb.String(""), Email: wrapperspb.String("a@b.c"), Role: service.UserRole_USER_ROLE_USER},
+		{Id: "1", Tags: &service.ListOfString{}, Unset: unsetUser(service.NullableUserField_NULLABLE_USER_FIELD_BIO, service.NullableUserField_NULLABLE_USER_FIELD_AGE)},
+		{Id: "1", Profile: &service.ProfileInput{Theme: service.Theme_THEME_AUTO, Unset: unsetProfile(service.NullableProfileField_NULLABLE_PROFILE_FIELD_TIMEZONE)}},
+		{Id: "1", Unset: unsetUser(service.NullableUserField_NULLABLE_USER_FIELD_PROFILE)},
+		{Id: "1", Bio: wrapperspb.String("x"), Unset: unsetUser(service.NullableUserField_NULLABLE_USER_FIELD_BIO)},
+	}
+	for _, seed := range seeds {
+		data, err := proto.Marshal(seed)
+		require.NoError(f, err)
+		f.Add(data, true)
+		f.Add(data, false)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte, withProfile bool) {
+		input := &service.UserInput{}
+		if err := proto.Unmarshal(data, input); err != nil {
+			t.Skip()
+		}
+
+		user := patchTestUser()
+		if !withProfile {
+			user.Profile = nil
+		}
+		before := proto.Clone(user).(*service.User)
+
+		diff, err := applyUserInput(user, input)
+		if err != nil {
+			require.True(t, proto.Equal(before, user), "user was modified by a rejected input")
+			require.Nil(t, diff)
+			return
+		}
+
+		require.Equal(t, before.Id, user.Id, "the ID must never change")
+		require.Equal(t, len(diff) == 0, proto.Equal(before, user), "diff %v doesn't match the change of the user", diff.fields())
+		require.Equal(t, diff, diffUsers(before, user))
+
+		// Applying the same input again is a no-op
+		again, err := applyUserInput(user, input)
+		require.NoError(t, err)
+		require.Empty(t, again, "input is not idempotent")
+	})
+}
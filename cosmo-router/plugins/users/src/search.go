@@ -0,0 +1,277 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+)
+
+// Weights of the searchable fields. Matches in short, descriptive fields such
+// as names and titles rank above matches in free text.
+const (
+	searchWeightName        = 3.0
+	searchWeightTitle       = 3.0
+	searchWeightDisplayName = 2.0
+	searchWeightEmail       = 2.0
+	searchWeightBio         = 1.0
+	searchWeightContent     = 1.0
+
+	// searchPrefixFactor scales the score of query terms that only match the
+	// beginning of an indexed term, e.g. "ali" matching "alice"
+	searchPrefixFactor = 0.5
+)
+
+// searchDoc identifies a record in the search index
+type searchDoc struct {
+	Type service.SearchableType
+	ID   string
+}
+
+// searchHit is a ranked search result
+type searchHit struct {
+	Doc   searchDoc
+	Score float64
+}
+
+// searchIndex is an in-process inverted index over users, posts and comments.
+// It maps every term to the records containing it, together with the weighted
+// frequency of the term in the record.
+//
+// The index is built from the stores on first use and must be kept up to date
+// by calling indexUser, indexPost and indexComment after records are saved and
+// unindex after they are deleted.
+// Updates that arrive before the index is built are ignored, because building
+// reads the stores after them. searchIndex is safe for concurrent use.
+type searchIndex struct {
+	mu sync.RWMutex
+
+	built    bool
+	postings map[string]map[searchDoc]float64
+	terms    map[searchDoc][]string
+}
+
+// newSearchIndex creates an empty index that is built on first use
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		postings: make(map[string]map[searchDoc]float64),
+		terms:    make(map[searchDoc][]string),
+	}
+}
+
+// ensureBuilt indexes all records of the stores unless the index has already been built
+func (x *searchIndex) ensureBuilt(ctx context.Context, users UserStore, posts PostStore, comments CommentStore) error {
+	x.mu.RLock()
+	built := x.built
+	x.mu.RUnlock()
+	if built {
+		return nil
+	}
+
+	// Hold the write lock while reading the stores, so updates that are made
+	// concurrently either are already visible in the stores or wait for the build
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.built {
+		return nil
+	}
+
+	allUsers, err := users.ListUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+	allPosts, err := posts.ListPosts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list posts: %w", err)
+	}
+	allComments, err := comments.ListComments(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	for _, user := range allUsers {
+		x.put(userSearchDoc(user))
+	}
+	for _, post := range allPosts {
+		x.put(postSearchDoc(post))
+	}
+	for _, comment := range allComments {
+		x.put(commentSearchDoc(comment))
+	}
+	x.built = true
+	return nil
+}
+
+// indexUser adds or replaces a user in the index
+func (x *searchIndex) indexUser(user *service.User) {
+	x.update(userSearchDoc(user))
+}
+
+// indexPost adds or replaces a post in the index
+func (x *searchIndex) indexPost(post *service.Post) {
+	x.update(postSearchDoc(post))
+}
+
+// indexComment adds or replaces a comment in the index
+func (x *searchIndex) indexComment(comment *service.Comment) {
+	x.update(commentSearchDoc(comment))
+}
+
+// unindex removes a record from the index
+func (x *searchIndex) unindex(doc searchDoc) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.remove(doc)
+}
+
+// update replaces a record in a built index
+func (x *searchIndex) update(doc searchDoc, fields map[string]float64) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.built {
+		x.put(doc, fields)
+	}
+}
+
+// put replaces the postings of a record with the weighted term frequencies in fields.
+// The caller must hold the write lock.
+func (x *searchIndex) put(doc searchDoc, fields map[string]float64) {
+	x.remove(doc)
+
+	terms := make([]string, 0, len(fields))
+	for term, weight := range fields {
+		if x.postings[term] == nil {
+			x.postings[term] = make(map[searchDoc]float64)
+		}
+		x.postings[term][doc] = weight
+		terms = append(terms, term)
+	}
+	x.terms[doc] = terms
+}
+
+// remove drops all postings of a record. The caller must hold the write lock.
+func (x *searchIndex) remove(doc searchDoc) {
+	for _, term := range x.terms[doc] {
+		delete(x.postings[term], doc)
+		if len(x.postings[term]) == 0 {
+			delete(x.postings, term)
+		}
+	}
+	delete(x.terms, doc)
+}
+
+// match returns the records matching all terms of query, best matches first.
+// Records are scored by TF-IDF over the weighted fields; a query term matches
+// indexed terms that are equal to it or, with a lower score, start with it.
+// Only records of the given types are returned; nil means all types.
+func (x *searchIndex) match(query string, types []service.SearchableType) []searchHit {
+	queryTerms := tokenize(query)
+	if len(queryTerms) == 0 {
+		return []searchHit{}
+	}
+
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	var scores map[searchDoc]float64
+	for _, queryTerm := range queryTerms {
+		termScores := x.scoreTerm(queryTerm, types)
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		// Every query term must match, so drop records missing this one
+		for doc, score := range scores {
+			if termScore, found := termScores[doc]; found {
+				scores[doc] = score + termScore
+			} else {
+				delete(scores, doc)
+			}
+		}
+	}
+
+	hits := make([]searchHit, 0, len(scores))
+	for doc, score := range scores {
+		hits = append(hits, searchHit{Doc: doc, Score: score})
+	}
+	slices.SortFunc(hits, func(a, b searchHit) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Doc.Type, b.Doc.Type); c != 0 {
+			return c
+		}
+		return compareIDs(a.Doc.ID, b.Doc.ID)
+	})
+	return hits
+}
+
+// scoreTerm scores all records of the given types that match a single query term.
+// The caller must hold the read lock.
+func (x *searchIndex) scoreTerm(queryTerm string, types []service.SearchableType) map[searchDoc]float64 {
+	scores := make(map[searchDoc]float64)
+	for term, docs := range x.postings {
+		factor := 1.0
+		if term != queryTerm {
+			if !strings.HasPrefix(term, queryTerm) {
+				continue
+			}
+			factor = searchPrefixFactor
+		}
+
+		// Rare terms say more about a record than common ones
+		idf := math.Log(1 + float64(len(x.terms))/float64(len(docs)))
+		for doc, weight := range docs {
+			if types != nil && !slices.Contains(types, doc.Type) {
+				continue
+			}
+			// A record matching the term exactly and by prefix counts the better match
+			scores[doc] = max(scores[doc], weight*idf*factor)
+		}
+	}
+	return scores
+}
+
+// userSearchDoc returns the weighted terms of a user
+func userSearchDoc(user *service.User) (searchDoc, map[string]float64) {
+	fields := make(map[string]float64)
+	addSearchTerms(fields, user.Name, searchWeightName)
+	addSearchTerms(fields, user.Email, searchWeightEmail)
+	addSearchTerms(fields, user.Bio.GetValue(), searchWeightBio)
+	addSearchTerms(fields, user.Profile.GetDisplayName().GetValue(), searchWeightDisplayName)
+	return searchDoc{Type: service.SearchableType_SEARCHABLE_TYPE_USER, ID: user.Id}, fields
+}
+
+// postSearchDoc returns the weighted terms of a post
+func postSearchDoc(post *service.Post) (searchDoc, map[string]float64) {
+	fields := make(map[string]float64)
+	addSearchTerms(fields, post.Title, searchWeightTitle)
+	return searchDoc{Type: service.SearchableType_SEARCHABLE_TYPE_POST, ID: post.Id}, fields
+}
+
+// commentSearchDoc returns the weighted terms of a comment
+func commentSearchDoc(comment *service.Comment) (searchDoc, map[string]float64) {
+	fields := make(map[string]float64)
+	addSearchTerms(fields, comment.Content, searchWeightContent)
+	return searchDoc{Type: service.SearchableType_SEARCHABLE_TYPE_COMMENT, ID: comment.Id}, fields
+}
+
+// addSearchTerms adds the terms of text to fields, each occurrence counting weight
+func addSearchTerms(fields map[string]float64, text string, weight float64) {
+	for _, term := range tokenize(text) {
+		fields[term] += weight
+	}
+}
+
+// tokenize splits text into lower-case terms at everything that isn't a letter or digit
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"alice", "example", "com"}, tokenize("Alice@Example.com"))
+	assert.Equal(t, []string{"full", "stack", "5", "years"}, tokenize("Full-stack, 5+ years!"))
+	assert.Equal(t, []string{"zoë", "münchen"}, tokenize("Zoë (München)"))
+	assert.Empty(t, tokenize(" -- "))
+}
+
+func TestSearchIndex(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	seedTestStores(t, &storeSet{users: store, posts: store, comments: store})
+
+	index := newSearchIndex()
+
+	// Updates before the first build are picked up by the build itself
+	index.indexPost(&service.Post{Id: "99", Title: "Ignored", AuthorId: "1"})
+	require.NoError(t, index.ensureBuilt(ctx, store, store, store))
+	assert.Empty(t, index.match("ignored", nil))
+
+	// docs returns the records of the hits in rank order
+	docs := func(hits []searchHit) []searchDoc {
+		result := make([]searchDoc, 0, len(hits))
+		for _, hit := range hits {
+			result = append(result, hit.Doc)
+		}
+		return result
+	}
+	user := func(id string) searchDoc { return searchDoc{Type: service.SearchableType_SEARCHABLE_TYPE_USER, ID: id} }
+	post := func(id string) searchDoc { return searchDoc{Type: service.SearchableType_SEARCHABLE_TYPE_POST, ID: id} }
+
+	// Bios are searchable, and every query term must match
+	assert.Equal(t, []searchDoc{user("1"), user("2")}, docs(index.match("developer", nil)))
+	assert.Equal(t, []searchDoc{user("2")}, docs(index.match("backend developer", nil)))
+
+	// Prefixes match, but rank below exact matches
+	require.NoError(t, store.SaveUser(ctx, &service.User{Id: "5", Name: "Graph"}))
+	index.indexUser(&service.User{Id: "5", Name: "Graph"})
+	assert.Equal(t, []searchDoc{user("5"), post("1")}, docs(index.match("graph", nil)))
+
+	// Types restrict the results, an empty list matches nothing
+	assert.Equal(t, []searchDoc{post("1")}, docs(index.match("graph", []service.SearchableType{service.SearchableType_SEARCHABLE_TYPE_POST})))
+	assert.Empty(t, index.match("graph", []service.SearchableType{}))
+
+	// Reindexing a record replaces its old terms
+	index.indexUser(&service.User{Id: "5", Name: "Renamed", Bio: wrapperspb.String("no longer matching")})
+	assert.Equal(t, []searchDoc{post("1")}, docs(index.match("graph", nil)))
+	assert.Equal(t, []searchDoc{user("5")}, docs(index.match("renamed", nil)))
+
+	assert.Empty(t, index.match("", nil))
+}
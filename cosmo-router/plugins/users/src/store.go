@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ErrNotFound is returned by stores when the requested record does not exist
+var ErrNotFound = errors.New("not found")
+
+// ErrCorrupt is returned by stores when a stored record or index can't be
+// decoded
+var ErrCorrupt = errors.New("corrupt record")
+
+// ErrVersionConflict is returned by stores when a user is saved with another
+// version than the stored one, because it has been changed since it was read
+var ErrVersionConflict = errors.New("version conflict")
+
+// UserStore persists users and exposes their activity.
+// Implementations must return ErrNotFound for unknown and deleted users, must be
+// safe for concurrent use and must not share the returned messages with other
+// callers.
+//
+// Users are versioned: a user must be saved with the version it was read with,
+// or with version 0 if it is new, otherwise the save fails with
+// ErrVersionConflict. Saving increments the version and sets updatedAt, both in
+// the store and in the saved messages.
+//
+// Activity is derived from the posts and comments written by a user, so stores
+// that implement UserStore must maintain an activity index that is updated
+// whenever a post or comment is saved.
+type UserStore interface {
+	// GetUser returns the user with the given ID, with recentActivity resolved
+	GetUser(ctx context.Context, id string) (*service.User, error)
+	// ListUsers returns all users in the store, with recentActivity resolved
+	ListUsers(ctx context.Context) ([]*service.User, error)
+	// SaveUser creates or replaces a user. The recentActivity field is ignored.
+	SaveUser(ctx context.Context, user *service.User) error
+	// SaveUsers creates or replaces several users in one transaction: either
+	// all of them are saved or none. The recentActivity field is ignored.
+	SaveUsers(ctx context.Context, users []*service.User) error
+	// ListUserActivity returns the posts and comments of a user, most recently
+	// created first, skipping records hidden by visiblePost and visibleComment
+	ListUserActivity(ctx context.Context, userID string) ([]*service.ActivityItem, error)
+	// DeleteUser marks a user as deleted at the given time, which advances its
+	// version. Posts and comments of the user are left untouched and must be
+	// deleted or reassigned first.
+	DeleteUser(ctx context.Context, id string, at time.Time) error
+	// RestoreUser clears the tombstone of a deleted user, which advances its
+	// version. Restoring a user that isn't deleted does nothing.
+	RestoreUser(ctx context.Context, id string) error
+}
+
+// PostStore persists posts.
+// Implementations must return ErrNotFound for unknown posts and for posts
+// hidden by visiblePost, such as deleted posts, must be safe for concurrent use
+// and must not share the returned messages with other callers.
+//
+// The comments of a post are derived from the comments that name it as their
+// post, so stores that implement PostStore must maintain an index of the
+// comments of each post that is updated whenever a comment is saved. Posts
+// list their comments oldest first, like activity skipping deleted comments.
+type PostStore interface {
+	// GetPost returns the post with the given ID, with comments resolved
+	GetPost(ctx context.Context, id string) (*service.Post, error)
+	// ListPosts returns all posts in the store, with comments resolved
+	ListPosts(ctx context.Context) ([]*service.Post, error)
+	// SavePost creates or replaces a post and sets its createdAt and updatedAt,
+	// both in the store and in the saved message. Posts keep their creation
+	// time when they are replaced. The comments field is ignored.
+	SavePost(ctx context.Context, post *service.Post) error
+	// DeletePost marks a post as deleted at the given time, which also becomes
+	// its updatedAt. Deleted posts stay in the activity index, but are skipped
+	// when the activity is read.
+	DeletePost(ctx context.Context, id string, at time.Time) error
+	// RestorePost clears the tombstone of a deleted post, which updates its
+	// updatedAt. Restoring a post that isn't deleted does nothing.
+	RestorePost(ctx context.Context, id string) error
+}
+
+// CommentStore persists comments.
+// Implementations must return ErrNotFound for unknown comments and for comments
+// hidden by visibleComment, such as deleted comments, must be safe for
+// concurrent use and must not share the returned messages with other callers.
+type CommentStore interface {
+	// GetComment returns the comment with the given ID
+	GetComment(ctx context.Context, id string) (*service.Comment, error)
+	// ListComments returns all comments in the store
+	ListComments(ctx context.Context) ([]*service.Comment, error)
+	// SaveComment creates or replaces a comment and sets its createdAt and
+	// updatedAt, both in the store and in the saved message. Comments keep their
+	// creation time when they are replaced.
+	SaveComment(ctx context.Context, comment *service.Comment) error
+	// DeleteComment marks a comment as deleted at the given time, which also
+	// becomes its updatedAt. Deleted comments stay in the activity index, but
+	// are skipped when the activity is read.
+	DeleteComment(ctx context.Context, id string, at time.Time) error
+	// RestoreComment clears the tombstone of a deleted comment, which updates its
+	// updatedAt. Restoring a comment that isn't deleted does nothing.
+	RestoreComment(ctx context.Context, id string) error
+}
+
+// AuditStore persists the audit log of the changes made by mutations.
+// Implementations must be safe for concurrent use and must not share the
+// returned messages with other callers. The records of a user are kept when
+// the user is deleted.
+type AuditStore interface {
+	// AppendAuditRecords assigns IDs to the records and appends them to the log
+	// in one transaction. IDs are increasing numbers, so later records have
+	// higher IDs.
+	AppendAuditRecords(ctx context.Context, records []*service.AuditRecord) error
+	// ListAuditRecords returns the audit records of a user, most recent first.
+	// Users without records, including unknown users, have an empty history.
+	ListAuditRecords(ctx context.Context, userID string) ([]*service.AuditRecord, error)
+}
+
+// stampUser checks that user is based on the stored version of the user, which
+// is nil for new users, and advances its version and updatedAt for a save
+func stampUser(user, stored *service.User, now time.Time) error {
+	var current int32
+	if stored != nil {
+		current = stored.Version
+	}
+	if user.Version != current {
+		return fmt.Errorf("%w: user %s is at version %d, not %d", ErrVersionConflict, user.Id, current, user.Version)
+	}
+	user.Version = current + 1
+	user.UpdatedAt = formatTimestamp(now)
+	return nil
+}
+
+// creationTime returns the creation time of a saved post or comment. Records
+// keep the creation time they were first stored with, and new records are
+// created now unless they already carry a creation time, like seeded records.
+func creationTime(createdAt, storedCreatedAt string, now time.Time) string {
+	switch {
+	case storedCreatedAt != "":
+		return storedCreatedAt
+	case createdAt != "":
+		return createdAt
+	}
+	return formatTimestamp(now)
+}
+
+// includeDeletedKey is the context key set by withDeleted
+type includeDeletedKey struct{}
+
+// withDeleted returns a context that makes store reads include deleted records.
+//
+// Records are never removed from the stores. Deleting a user, post or comment
+// sets its deletedAt tombstone instead, and reads skip records with a tombstone
+// unless their context has been derived from withDeleted. Restoring a record
+// clears the tombstone.
+func withDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedKey{}, true)
+}
+
+// visible reports whether a record with the given tombstone is returned by
+// store reads with ctx
+func visible(ctx context.Context, deletedAt *wrapperspb.StringValue) bool {
+	if deletedAt == nil {
+		return true
+	}
+	include, _ := ctx.Value(includeDeletedKey{}).(bool)
+	return include
+}
+
+// viewerKey is the context key set by withViewer
+type viewerKey struct{}
+
+// withViewer returns a context that makes store reads hide the drafts and
+// archived posts of users other than viewerID, everywhere posts are returned.
+// Without a viewer, store reads return posts of every status.
+func withViewer(ctx context.Context, viewerID string) context.Context {
+	return context.WithValue(ctx, viewerKey{}, viewerID)
+}
+
+// visiblePost reports whether a post is returned by store reads with ctx
+func visiblePost(ctx context.Context, post *service.Post) bool {
+	return visible(ctx, post.DeletedAt) && shownToViewer(ctx, post)
+}
+
+// visibleComment reports whether a comment on post is returned by store reads
+// with ctx. Comments on drafts and archived posts that the viewer can't see
+// are hidden with the post, while comments on deleted posts stay visible. post
+// is nil if the post of the comment doesn't exist.
+func visibleComment(ctx context.Context, comment *service.Comment, post *service.Post) bool {
+	return visible(ctx, comment.DeletedAt) && (post == nil || shownToViewer(ctx, post))
+}
+
+// shownToViewer reports whether the status of a post lets the viewer of ctx
+// see it
+func shownToViewer(ctx context.Context, post *service.Post) bool {
+	viewerID, restricted := ctx.Value(viewerKey{}).(string)
+	return !restricted || post.Status == service.PostStatus_POST_STATUS_PUBLISHED || post.AuthorId == viewerID
+}
+
+// timestampLayout is the RFC 3339 layout of timestamps. They have a fixed
+// number of fractional digits and are in UTC, so they sort lexicographically.
+const timestampLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// formatTimestamp formats a point in time as an RFC 3339 string in UTC
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(timestampLayout)
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// testStoreConfigs returns the configuration of every store backend
+func testStoreConfigs() map[string]func(t *testing.T) *Config {
+	return map[string]func(t *testing.T) *Config{
+		"memory": func(t *testing.T) *Config {
+			return &Config{StoreBackend: storeBackendMemory}
+		},
+		"bolt": func(t *testing.T) *Config {
+			return &Config{StoreBackend: storeBackendBolt, StorePath: filepath.Join(t.TempDir(), "users.db")}
+		},
+	}
+}
+
+func TestUserVersions(t *testing.T) {
+	for name, config := range testStoreConfigs() {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			stores, err := openStore(config(t))
+			require.NoError(t, err)
+			defer stores.close()
+			seedTestStores(t, stores)
+			users := stores.users
+
+			alice, err := users.GetUser(ctx, "1")
+			require.NoError(t, err)
+			assert.Equal(t, int32(1), alice.Version, "seeded users start at version 1")
+			seededAt, err := time.Parse(time.RFC3339, alice.UpdatedAt)
+			require.NoError(t, err)
+
+			// Saving advances the version, also in the saved message
+			stale, err := users.GetUser(ctx, "1")
+			require.NoError(t, err)
+			alice.Name = "Alice v2"
+			require.NoError(t, users.SaveUser(ctx, alice))
+			assert.Equal(t, int32(2), alice.Version)
+			updatedAt, err := time.Parse(time.RFC3339, alice.UpdatedAt)
+			require.NoError(t, err)
+			assert.False(t, updatedAt.Before(seededAt))
+
+			// A save based on the old version is rejected
+			stale.Name = "Alice stale"
+			err = users.SaveUser(ctx, stale)
+			require.ErrorIs(t, err, ErrVersionConflict)
+			assert.Equal(t, int32(1), stale.Version, "a rejected save doesn't change the version")
+
+			stored, err := users.GetUser(ctx, "1")
+			require.NoError(t, err)
+			assert.Equal(t, "Alice v2", stored.Name)
+			assert.Equal(t, int32(2), stored.Version)
+			assert.Equal(t, alice.UpdatedAt, stored.UpdatedAt)
+
+			// New users must not claim a version
+			err = users.SaveUser(ctx, &service.User{Id: "20", Name: "Ghost", Version: 3})
+			require.ErrorIs(t, err, ErrVersionConflict)
+			_, err = users.GetUser(ctx, "20")
+			require.ErrorIs(t, err, ErrNotFound)
+
+			// One conflict rejects the whole batch
+			bob, err := users.GetUser(ctx, "2")
+			require.NoError(t, err)
+			bob.Name = "Bob batch"
+			err = users.SaveUsers(ctx, []*service.User{bob, stale})
+			require.ErrorIs(t, err, ErrVersionConflict)
+			assert.Equal(t, int32(1), bob.Version)
+			bob, err = users.GetUser(ctx, "2")
+			require.NoError(t, err)
+			assert.Equal(t, "Bob Smith", bob.Name)
+		})
+	}
+}
+
+func TestConcurrentUserSaves(t *testing.T) {
+	const writers = 20
+
+	for name, config := range testStoreConfigs() {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			stores, err := openStore(config(t))
+			require.NoError(t, err)
+			defer stores.close()
+			seedTestStores(t, stores)
+
+			// Every writer reads the user and saves it once, so writers that
+			// read the same version race and only one of them may win
+			var saved sync.WaitGroup
+			var mu sync.Mutex
+			succeeded := 0
+			for i := 0; i < writers; i++ {
+				saved.Add(1)
+				go func() {
+					defer saved.Done()
+					user, err := stores.users.GetUser(ctx, "1")
+					if !assert.NoError(t, err) {
+						return
+					}
+					user.Name = "Alice " + user.UpdatedAt
+					err = stores.users.SaveUser(ctx, user)
+					if err == nil {
+						mu.Lock()
+						succeeded++
+						mu.Unlock()
+						return
+					}
+					assert.ErrorIs(t, err, ErrVersionConflict)
+				}()
+			}
+			saved.Wait()
+
+			user, err := stores.users.GetUser(ctx, "1")
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, succeeded, 1)
+			assert.Equal(t, int32(1+succeeded), user.Version, "every successful save advances the version exactly once")
+		})
+	}
+}
+
+func TestAuditRecords(t *testing.T) {
+	for name, config := range testStoreConfigs() {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			stores, err := openStore(config(t))
+			require.NoError(t, err)
+			defer stores.close()
+			seedTestStores(t, stores)
+
+			records := []*service.AuditRecord{
+				{UserId: "1", Action: service.AuditAction_AUDIT_ACTION_UPDATE_USER, Timestamp: "2024-05-01T10:00:00.000Z"},
+				{UserId: "2", Action: service.AuditAction_AUDIT_ACTION_UPDATE_USER, Timestamp: "2024-05-01T10:00:00.000Z"},
+			}
+			require.NoError(t, stores.audit.AppendAuditRecords(ctx, records))
+			assert.Equal(t, "1", records[0].Id, "the store assigns IDs")
+			assert.Equal(t, "2", records[1].Id)
+
+			deleted := &service.AuditRecord{UserId: "1", Action: service.AuditAction_AUDIT_ACTION_DELETE_USER, Changes: []*service.FieldChange{
+				{Field: "name", OldValue: wrapperspb.String(`"Alice Johnson"`)},
+			}}
+			require.NoError(t, stores.audit.AppendAuditRecords(ctx, []*service.AuditRecord{deleted}))
+			require.NoError(t, stores.users.DeleteUser(ctx, "1", time.Now()))
+
+			// The history is kept when the user is deleted
+			history, err := stores.audit.ListAuditRecords(ctx, "1")
+			require.NoError(t, err)
+			require.Len(t, history, 2)
+			assert.Equal(t, "3", history[0].Id, "most recent first")
+			assert.Equal(t, `"Alice Johnson"`, history[0].Changes[0].OldValue.GetValue())
+			assert.Equal(t, "1", history[1].Id)
+
+			history, err = stores.audit.ListAuditRecords(ctx, "999")
+			require.NoError(t, err)
+			assert.Empty(t, history)
+		})
+	}
+}
+
+func TestCommentVisibility(t *testing.T) {
+	for name, config := range testStoreConfigs() {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			stores, err := openStore(config(t))
+			require.NoError(t, err)
+			defer stores.close()
+			seedTestStores(t, stores)
+
+			// Post 3 of user 2 carries comment 3 of user 4 and comment 4 of user 1
+			post, err := stores.posts.GetPost(ctx, "3")
+			require.NoError(t, err)
+			post.Status = service.PostStatus_POST_STATUS_DRAFT
+			require.NoError(t, stores.posts.SavePost(ctx, post))
+
+			// commentIDs lists the IDs of the comments that ctx reads
+			commentIDs := func(t *testing.T, ctx context.Context) []string {
+				t.Helper()
+				comments, err := stores.comments.ListComments(ctx)
+				require.NoError(t, err)
+				ids := make([]string, 0, len(comments))
+				for _, comment := range comments {
+					ids = append(ids, comment.Id)
+				}
+				slices.SortFunc(ids, compareIDs)
+				return ids
+			}
+
+			// Comments on a draft are hidden from everyone but its author
+			alice := withViewer(ctx, "1")
+			_, err = stores.comments.GetComment(alice, "4")
+			assert.ErrorIs(t, err, ErrNotFound)
+			assert.Equal(t, []string{"1", "2"}, commentIDs(t, alice))
+			activity, err := stores.users.ListUserActivity(alice, "1")
+			require.NoError(t, err)
+			for _, item := range activity {
+				assert.NotEqual(t, "4", item.GetComment().GetId())
+			}
+
+			_, err = stores.comments.GetComment(withViewer(ctx, "2"), "4")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"1", "2", "3", "4"}, commentIDs(t, withViewer(ctx, "2")))
+			assert.Equal(t, []string{"1", "2", "3", "4"}, commentIDs(t, ctx))
+
+			// Comments on deleted posts stay visible
+			require.NoError(t, stores.posts.DeletePost(ctx, "1", time.Now()))
+			assert.Equal(t, []string{"1", "2"}, commentIDs(t, alice))
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+	// Embed the IANA time zone database, so timezones validate the same on
+	// every host, also on those without zoneinfo files
+	_ "time/tzdata"
+	"unicode/utf8"
+
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// maxPostTitleLength is the maximum number of characters in a post title
+const maxPostTitleLength = 200
+
+// maxCommentLength is the maximum number of characters in a comment
+const maxCommentLength = 2000
+
+// knownPermissions are the permissions that can be granted to users
+var knownPermissions = []string{"read", "write", "delete", "admin"}
+
+// fieldRule validates a single field of an input of type T
+type fieldRule[T any] struct {
+	// Field is the path of the field within the input
+	Field string
+	// Check describes what is wrong with the field, or returns "" if the field is valid
+	Check func(input T) string
+}
+
+// userInputRules validate the fields of a user update
+var userInputRules = []fieldRule[*service.UserInput]{
+	{Field: "name", Check: func(in *service.UserInput) string { return optional(in.GetName(), checkNotBlank) }},
+	{Field: "email", Check: func(in *service.UserInput) string { return optional(in.GetEmail(), checkEmail) }},
+	{Field: "age", Check: func(in *service.UserInput) string { return checkAge(in.GetAge()) }},
+	{Field: "permissions", Check: func(in *service.UserInput) string { return checkPermissions(in.GetPermissions().GetList().GetItems()) }},
+	{Field: "expectedVersion", Check: func(in *service.UserInput) string { return checkVersion(in.GetExpectedVersion()) }},
+}
+
+// createUserInputRules validate the fields of a new user
+var createUserInputRules = []fieldRule[*service.CreateUserInput]{
+	{Field: "name", Check: func(in *service.CreateUserInput) string { return checkNotBlank(in.GetName()) }},
+	{Field: "email", Check: func(in *service.CreateUserInput) string { return checkEmail(in.GetEmail()) }},
+	{Field: "age", Check: func(in *service.CreateUserInput) string { return checkAge(in.GetAge()) }},
+	{Field: "permissions", Check: func(in *service.CreateUserInput) string {
+		return checkPermissions(in.GetPermissions().GetList().GetItems())
+	}},
+}
+
+// profileInputRules validate the fields of a profile, in new users and updates
+var profileInputRules = []fieldRule[*service.ProfileInput]{
+	{Field: "timezone", Check: func(in *service.ProfileInput) string { return optional(in.GetTimezone(), checkTimezone) }},
+}
+
+// postInputRules validate the fields of a new post
+var postInputRules = []fieldRule[*service.PostInput]{
+	{Field: "title", Check: func(in *service.PostInput) string { return checkPostTitle(in.GetTitle()) }},
+	{Field: "authorId", Check: func(in *service.PostInput) string { return checkNotBlank(in.GetAuthorId()) }},
+}
+
+// updatePostInputRules validate the fields of a post edit
+var updatePostInputRules = []fieldRule[*service.UpdatePostInput]{
+	{Field: "id", Check: func(in *service.UpdatePostInput) string { return checkNotBlank(in.GetId()) }},
+	{Field: "title", Check: func(in *service.UpdatePostInput) string { return optional(in.GetTitle(), checkPostTitle) }},
+}
+
+// commentInputRules validate the fields of a new comment
+var commentInputRules = []fieldRule[*service.CommentInput]{
+	{Field: "postId", Check: func(in *service.CommentInput) string { return checkNotBlank(in.GetPostId()) }},
+	{Field: "authorId", Check: func(in *service.CommentInput) string { return checkNotBlank(in.GetAuthorId()) }},
+	{Field: "content", Check: func(in *service.CommentInput) string { return checkCommentContent(in.GetContent()) }},
+}
+
+// updateCommentInputRules validate the fields of a comment edit
+var updateCommentInputRules = []fieldRule[*service.UpdateCommentInput]{
+	{Field: "id", Check: func(in *service.UpdateCommentInput) string { return checkNotBlank(in.GetId()) }},
+	{Field: "content", Check: func(in *service.UpdateCommentInput) string { return checkCommentContent(in.GetContent()) }},
+}
+
+// validateUserInput returns all invalid fields of a user update
+func validateUserInput(input *service.UserInput) []*fieldError {
+	errs := applyRules(input, userInputRules, "")
+	if input.Profile != nil {
+		errs = append(errs, applyRules(input.Profile, profileInputRules, "profile.")...)
+	}
+	if err := validateUnset(input); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// validateCreateUserInput returns all invalid fields of a new user
+func validateCreateUserInput(input *service.CreateUserInput) []*fieldError {
+	errs := applyRules(input, createUserInputRules, "")
+	if input.Profile != nil {
+		errs = append(errs, applyRules(input.Profile, profileInputRules, "profile.")...)
+	}
+	return errs
+}
+
+// validatePostInput returns all invalid fields of a new post
+func validatePostInput(input *service.PostInput) []*fieldError {
+	return applyRules(input, postInputRules, "")
+}
+
+// validateUpdatePostInput returns all invalid fields of a post edit
+func validateUpdatePostInput(input *service.UpdatePostInput) []*fieldError {
+	return applyRules(input, updatePostInputRules, "")
+}
+
+// validateCommentInput returns all invalid fields of a new comment
+func validateCommentInput(input *service.CommentInput) []*fieldError {
+	return applyRules(input, commentInputRules, "")
+}
+
+// validateUpdateCommentInput returns all invalid fields of a comment edit
+func validateUpdateCommentInput(input *service.UpdateCommentInput) []*fieldError {
+	return applyRules(input, updateCommentInputRules, "")
+}
+
+// applyRules runs rules against input and returns the failures in rule order,
+// with prefix added to the field paths
+func applyRules[T any](input T, rules []fieldRule[T], prefix string) []*fieldError {
+	var errs []*fieldError
+	for _, rule := range rules {
+		if message := rule.Check(input); message != "" {
+			errs = append(errs, &fieldError{Field: prefix + rule.Field, Message: message})
+		}
+	}
+	return errs
+}
+
+// optional runs check on the value of a nullable string if it is given
+func optional(value *wrapperspb.StringValue, check func(string) string) string {
+	if value == nil {
+		return ""
+	}
+	return check(value.Value)
+}
+
+// checkNotBlank requires a string with at least one non-space character
+func checkNotBlank(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "must not be empty"
+	}
+	return ""
+}
+
+// checkEmail requires a plain email address like alice@example.com, without a display name
+func checkEmail(value string) string {
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Address != value || address.Name != "" {
+		return "must be a valid email address"
+	}
+	return ""
+}
+
+// checkAge requires a non-negative age if one is given
+func checkAge(age *wrapperspb.Int32Value) string {
+	if age != nil && age.Value < 0 {
+		return fmt.Sprintf("must not be negative, got %d", age.Value)
+	}
+	return ""
+}
+
+// checkVersion requires a version of at least 1, the version of new users, if one is given
+func checkVersion(version *wrapperspb.Int32Value) string {
+	if version != nil && version.Value < 1 {
+		return fmt.Sprintf("must be at least 1, got %d", version.Value)
+	}
+	return ""
+}
+
+// checkPermissions requires every permission to be a known one
+func checkPermissions(permissions []string) string {
+	for _, permission := range permissions {
+		if !slices.Contains(knownPermissions, permission) {
+			return fmt.Sprintf("unknown permission %q, expected one of %s", permission, strings.Join(knownPermissions, ", "))
+		}
+	}
+	return ""
+}
+
+// checkTimezone requires the name of a zone of the IANA time zone database, like Europe/Berlin
+func checkTimezone(value string) string {
+	// LoadLocation also accepts "" and "Local", which aren't zone names
+	if value == "" || value == "Local" {
+		return "must be an IANA time zone name like Europe/Berlin"
+	}
+	if _, err := time.LoadLocation(value); err != nil {
+		return "must be an IANA time zone name like Europe/Berlin"
+	}
+	return ""
+}
+
+// checkPostTitle requires a non-empty title of at most maxPostTitleLength characters
+func checkPostTitle(value string) string {
+	if message := checkNotBlank(value); message != "" {
+		return message
+	}
+	if length := utf8.RuneCountInString(value); length > maxPostTitleLength {
+		return fmt.Sprintf("must be at most %d characters long, got %d", maxPostTitleLength, length)
+	}
+	return ""
+}
+
+// checkCommentContent requires non-empty content of at most maxCommentLength characters
+func checkCommentContent(value string) string {
+	if message := checkNotBlank(value); message != "" {
+		return message
+	}
+	if length := utf8.RuneCountInString(value); length > maxCommentLength {
+		return fmt.Sprintf("must be at most %d characters long, got %d", maxCommentLength, length)
+	}
+	return ""
+}
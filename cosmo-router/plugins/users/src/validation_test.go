@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/wundergraph/cosmo/plugin/generated"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// invalidFields returns the paths of the invalid fields
+func invalidFields(errs []*fieldError) []string {
+	fields := make([]string, 0, len(errs))
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+	return fields
+}
+
+func TestValidateUserInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *service.UserInput
+		want  []string
+	}{
+		{name: "empty update", input: &service.UserInput{Id: "1"}},
+		{name: "valid fields", input: &service.UserInput{
+			Id:          "1",
+			Name:        wrapperspb.String("Alice"),
+			Email:       wrapperspb.String("alice+test@mail.example.com"),
+			Age:         wrapperspb.Int32(0),
+			Permissions: &service.ListOfString{List: &service.ListOfString_List{Items: []string{"read", "write", "delete", "admin"}}},
+			Profile:     &service.ProfileInput{Timezone: wrapperspb.String("America/Argentina/Buenos_Aires")},
+		}},
+		{name: "blank name", input: &service.UserInput{Id: "1", Name: wrapperspb.String("  ")}, want: []string{"name"}},
+		{name: "email without domain", input: &service.UserInput{Id: "1", Email: wrapperspb.String("alice")}, want: []string{"email"}},
+		{name: "empty email", input: &service.UserInput{Id: "1", Email: wrapperspb.String("")}, want: []string{"email"}},
+		{name: "email with display name", input: &service.UserInput{Id: "1", Email: wrapperspb.String("Alice <alice@example.com>")}, want: []string{"email"}},
+		{name: "negative age", input: &service.UserInput{Id: "1", Age: wrapperspb.Int32(-1)}, want: []string{"age"}},
+		{name: "unknown permission", input: &service.UserInput{Id: "1", Permissions: &service.ListOfString{List: &service.ListOfString_List{Items: []string{"read", "root"}}}}, want: []string{"permissions"}},
+		{name: "unknown timezone", input: &service.UserInput{Id: "1", Profile: &service.ProfileInput{Timezone: wrapperspb.String("Mars/Olympus_Mons")}}, want: []string{"profile.timezone"}},
+		{name: "local timezone", input: &service.UserInput{Id: "1", Profile: &service.ProfileInput{Timezone: wrapperspb.String("Local")}}, want: []string{"profile.timezone"}},
+		{name: "empty timezone", input: &service.UserInput{Id: "1", Profile: &service.ProfileInput{Timezone: wrapperspb.String("")}}, want: []string{"profile.timezone"}},
+		{name: "expected version 0", input: &service.UserInput{Id: "1", ExpectedVersion: wrapperspb.Int32(0)}, want: []string{"expectedVersion"}},
+		{name: "set and unset", input: &service.UserInput{Id: "1", Age: wrapperspb.Int32(3), Unset: unsetUser(service.NullableUserField_NULLABLE_USER_FIELD_AGE)}, want: []string{"age"}},
+		{name: "every failure is reported", input: &service.UserInput{
+			Id:      "1",
+			Email:   wrapperspb.String("not an email"),
+			Age:     wrapperspb.Int32(-5),
+			Profile: &service.ProfileInput{Timezone: wrapperspb.String("Nowhere")},
+		}, want: []string{"email", "age", "profile.timezone"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateUserInput(tt.input)
+			if tt.want == nil {
+				assert.Empty(t, errs)
+				return
+			}
+			assert.Equal(t, tt.want, invalidFields(errs))
+		})
+	}
+}
+
+func TestValidateCreateUserInput(t *testing.T) {
+	valid := func() *service.CreateUserInput {
+		return &service.CreateUserInput{Name: "Dana", Email: "dana@example.com", Role: service.UserRole_USER_ROLE_USER}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(in *service.CreateUserInput)
+		want   []string
+	}{
+		{name: "valid", modify: func(in *service.CreateUserInput) {}},
+		{name: "missing name", modify: func(in *service.CreateUserInput) { in.Name = "" }, want: []string{"name"}},
+		{name: "missing email", modify: func(in *service.CreateUserInput) { in.Email = "" }, want: []string{"email"}},
+		{name: "invalid email", modify: func(in *service.CreateUserInput) { in.Email = "dana@" }, want: []string{"email"}},
+		{name: "negative age", modify: func(in *service.CreateUserInput) { in.Age = wrapperspb.Int32(-30) }, want: []string{"age"}},
+		{name: "unknown permission", modify: func(in *service.CreateUserInput) {
+			in.Permissions = &service.ListOfString{List: &service.ListOfString_List{Items: []string{"superuser"}}}
+		}, want: []string{"permissions"}},
+		{name: "unknown timezone", modify: func(in *service.CreateUserInput) {
+			in.Profile = &service.ProfileInput{Timezone: wrapperspb.String("Europe/Atlantis")}
+		}, want: []string{"profile.timezone"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := valid()
+			tt.modify(input)
+			errs := validateCreateUserInput(input)
+			if tt.want == nil {
+				assert.Empty(t, errs)
+				return
+			}
+			assert.Equal(t, tt.want, invalidFields(errs))
+		})
+	}
+}
+
+func TestValidatePostInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *service.PostInput
+		want  []string
+	}{
+		{name: "valid", input: &service.PostInput{Title: "Hello", AuthorId: "1"}},
+		{name: "longest title", input: &service.PostInput{Title: strings.Repeat("a", maxPostTitleLength), AuthorId: "1"}},
+		{name: "longest multi-byte title", input: &service.PostInput{Title: strings.Repeat("ü", maxPostTitleLength), AuthorId: "1"}},
+		{name: "empty title", input: &service.PostInput{Title: "", AuthorId: "1"}, want: []string{"title"}},
+		{name: "blank title", input: &service.PostInput{Title: " \t\n", AuthorId: "1"}, want: []string{"title"}},
+		{name: "title too long", input: &service.PostInput{Title: strings.Repeat("a", maxPostTitleLength+1), AuthorId: "1"}, want: []string{"title"}},
+		{name: "missing author", input: &service.PostInput{Title: "Hello"}, want: []string{"authorId"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validatePostInput(tt.input)
+			if tt.want == nil {
+				assert.Empty(t, errs)
+				return
+			}
+			assert.Equal(t, tt.want, invalidFields(errs))
+		})
+	}
+}
+
+func TestValidateUpdatePostInput(t *testing.T) {
+	assert.Empty(t, validateUpdatePostInput(&service.UpdatePostInput{Id: "1"}))
+	assert.Empty(t, validateUpdatePostInput(&service.UpdatePostInput{Id: "1", Title: wrapperspb.String("Hello")}))
+	assert.Equal(t, []string{"id", "title"}, invalidFields(validateUpdatePostInput(&service.UpdatePostInput{Title: wrapperspb.String(" ")})))
+	assert.Equal(t, []string{"title"}, invalidFields(validateUpdatePostInput(&service.UpdatePostInput{
+		Id: "1", Title: wrapperspb.String(strings.Repeat("a", maxPostTitleLength+1)),
+	})))
+}
+
+func TestValidateCommentInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *service.CommentInput
+		want  []string
+	}{
+		{name: "valid", input: &service.CommentInput{PostId: "1", AuthorId: "1", Content: "Nice"}},
+		{name: "longest content", input: &service.CommentInput{PostId: "1", AuthorId: "1", Content: strings.Repeat("ü", maxCommentLength)}},
+		{name: "blank content", input: &service.CommentInput{PostId: "1", AuthorId: "1", Content: " \n"}, want: []string{"content"}},
+		{name: "content too long", input: &service.CommentInput{PostId: "1", AuthorId: "1", Content: strings.Repeat("a", maxCommentLength+1)}, want: []string{"content"}},
+		{name: "missing post and author", input: &service.CommentInput{Content: "Nice"}, want: []string{"postId", "authorId"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateCommentInput(tt.input)
+			if tt.want == nil {
+				assert.Empty(t, errs)
+				return
+			}
+			assert.Equal(t, tt.want, invalidFields(errs))
+		})
+	}
+
+	assert.Empty(t, validateUpdateCommentInput(&service.UpdateCommentInput{Id: "1", Content: "Nice"}))
+	assert.Equal(t, []string{"id", "content"}, invalidFields(validateUpdateCommentInput(&service.UpdateCommentInput{})))
+}
+
+// fieldViolations returns the field violations of an InvalidArgument status error
+func fieldViolations(t *testing.T, err error) map[string]string {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok, "not a status error: %v", err)
+	require.Equal(t, codes.InvalidArgument, st.Code(), st.Message())
+
+	violations := make(map[string]string)
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				violations[violation.Field] = violation.Description
+			}
+		}
+	}
+	return violations
+}
+
+func TestMutationValidation(t *testing.T) {
+	svc := setupTestService(t)
+	defer svc.cleanup()
+	ctx := context.Background()
+
+	t.Run("updateUser", func(t *testing.T) {
+		_, err := svc.usersClient.MutationUpdateUser(ctx, &service.MutationUpdateUserRequest{Input: &service.UserInput{
+			Id:      "1",
+			Email:   wrapperspb.String("alice(at)example.com"),
+			Profile: &service.ProfileInput{Timezone: wrapperspb.String("Moon/Tranquility")},
+		}})
+		violations := fieldViolations(t, err)
+		assert.Equal(t, "must be a valid email address", violations["input.email"])
+		assert.Contains(t, violations, "input.profile.timezone")
+		assert.Len(t, violations, 2)
+
+		// Nothing was saved
+		user, err := svc.stores.users.GetUser(ctx, "1")
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", user.Email)
+	})
+
+	t.Run("updateUsers", func(t *testing.T) {
+		resp, err := svc.usersClient.MutationUpdateUsers(ctx, &service.MutationUpdateUsersRequest{Input: []*service.UserInput{
+			{Id: "1", Age: wrapperspb.Int32(-1)},
+			{Id: "2", Age: wrapperspb.Int32(40)},
+		}})
+		require.NoError(t, err)
+		results := resp.UpdateUsers.GetResults()
+		require.Len(t, results, 2)
+		assert.Equal(t, "age", results[0].GetValidationFailed().GetField().GetValue())
+		assert.Equal(t, "must not be negative, got -1", results[0].GetValidationFailed().GetMessage())
+		assert.NotNil(t, results[1].GetUpdatedUser())
+	})
+
+	t.Run("createUser", func(t *testing.T) {
+		_, err := svc.usersClient.MutationCreateUser(ctx, &service.MutationCreateUserRequest{Input: &service.CreateUserInput{
+			Name:        "Dana",
+			Email:       "dana",
+			Role:        service.UserRole_USER_ROLE_USER,
+			Permissions: &service.ListOfString{List: &service.ListOfString_List{Items: []string{"everything"}}},
+		}})
+		violations := fieldViolations(t, err)
+		assert.Contains(t, violations, "input.email")
+		assert.Contains(t, violations, "input.permissions")
+
+		_, err = svc.usersClient.MutationCreateUser(ctx, &service.MutationCreateUserRequest{})
+		assert.Contains(t, fieldViolations(t, err), "input")
+	})
+
+	t.Run("createPost", func(t *testing.T) {
+		_, err := svc.usersClient.MutationCreatePost(ctx, &service.MutationCreatePostRequest{Input: &service.PostInput{
+			Title:    strings.Repeat("x", maxPostTitleLength+1),
+			AuthorId: "1",
+		}})
+		assert.Equal(t, "must be at most 200 characters long, got 201", fieldViolations(t, err)["input.title"])
+
+		posts, err := svc.stores.posts.ListPosts(ctx)
+		require.NoError(t, err)
+		assert.Len(t, posts, 4)
+	})
+
+	t.Run("createComment", func(t *testing.T) {
+		_, err := svc.usersClient.MutationCreateComment(ctx, &service.MutationCreateCommentRequest{Input: &service.CommentInput{
+			PostId:   "1",
+			AuthorId: "1",
+			Content:  strings.Repeat("x", maxCommentLength+1),
+		}})
+		assert.Equal(t, "must be at most 2000 characters long, got 2001", fieldViolations(t, err)["input.content"])
+
+		_, err = svc.usersClient.MutationUpdateComment(ctx, &service.MutationUpdateCommentRequest{Input: &service.UpdateCommentInput{Id: "1"}})
+		assert.Equal(t, "must not be empty", fieldViolations(t, err)["input.content"])
+
+		post, err := svc.stores.posts.GetPost(ctx, "1")
+		require.NoError(t, err)
+		require.Len(t, post.Comments, 2)
+		assert.Equal(t, "Great post! Very helpful.", post.Comments[0].Content)
+	})
+}